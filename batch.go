@@ -0,0 +1,80 @@
+package climacell
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// RealtimeResult is the outcome of the realtime call for a single location of a batch
+type RealtimeResult struct {
+	Location Location
+	Data     *RealtimeData
+	Err      error
+}
+
+// BatchError is returned when one or more locations of a batch failed, the errors
+// themselves are stored in the results of the failed locations
+type BatchError struct {
+	Total  int
+	Failed []int
+}
+
+// Error returns the string representation of the BatchError
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("batch failed for %v of %v locations", len(e.Failed), e.Total)
+}
+
+// RealtimeBatch calls the realtime climacell endpoint for every location using at most workers
+// concurrent requests, see RealtimeBatchWithContext
+func (c *Client) RealtimeBatch(locations []Location, workers int, unit unit, fields ...Field) ([]RealtimeResult, error) {
+	return c.RealtimeBatchWithContext(context.Background(), locations, workers, unit, fields...)
+}
+
+// RealtimeBatchWithContext calls the realtime climacell endpoint for every location using at most workers
+// concurrent requests. The results are in the same order as the locations, a failed location doesn't stop
+// the batch: its error is stored in its result and a BatchError listing the failed indices is returned
+func (c *Client) RealtimeBatchWithContext(ctx context.Context, locations []Location, workers int, unit unit, fields ...Field) ([]RealtimeResult, error) {
+	if workers < 1 {
+		workers = 1
+	}
+
+	results := make([]RealtimeResult, len(locations))
+	indices := make(chan int)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < workers && i < len(locations); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for index := range indices {
+				location := locations[index]
+				data, err := c.RealtimeWithContext(ctx, location, unit, fields...)
+				results[index] = RealtimeResult{Location: location, Data: data, Err: err}
+			}
+		}()
+	}
+
+	for index := range locations {
+		indices <- index
+	}
+
+	close(indices)
+	wg.Wait()
+
+	var failed []int
+
+	for index, result := range results {
+		if result.Err != nil {
+			failed = append(failed, index)
+		}
+	}
+
+	if failed != nil {
+		return results, &BatchError{Total: len(locations), Failed: failed}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,140 @@
+package climacell
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBatchError_Error(t *testing.T) {
+	e := &BatchError{Total: 300, Failed: []int{4, 20}}
+	want := "batch failed for 2 of 300 locations"
+	if got := e.Error(); got != want {
+		t.Errorf("Error() = %v, want %v", got, want)
+	}
+}
+
+func TestClient_RealtimeBatch(t *testing.T) {
+	var inFlight, maxInFlight int32
+
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+				break
+			}
+		}
+
+		time.Sleep(5 * time.Millisecond)
+
+		latitude, _ := strconv.ParseFloat(r.URL.Query().Get("lat"), 64)
+
+		if latitude == 13 {
+			w.WriteHeader(500)
+			w.Write([]byte(`{"message": "mock error message"}`))
+			return
+		}
+
+		w.WriteHeader(200)
+		w.Write([]byte(fmt.Sprintf(`{"temp": {"value": %v, "units": "C"}}`, latitude)))
+	})
+
+	defer closeFunc()
+
+	c, err := NewClient("apikey", srv.Client())
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	var locations []Location
+
+	for i := 0; i < 20; i++ {
+		locations = append(locations, Location{Latitude: float64(i), Longitude: 4.95})
+	}
+
+	// Invalid coordinates only fail their own location
+	locations[7].Longitude = 181
+
+	results, err := c.RealtimeBatch(locations, 4, Si, Temperature)
+
+	var batchError *BatchError
+	if assert.ErrorAs(t, err, &batchError) {
+		assert.Equal(t, 20, batchError.Total)
+		assert.Equal(t, []int{7, 13}, batchError.Failed)
+	}
+
+	assert.Len(t, results, 20)
+	assert.True(t, atomic.LoadInt32(&maxInFlight) <= 4)
+
+	for i, result := range results {
+		assert.Equal(t, locations[i], result.Location)
+
+		switch i {
+		case 7:
+			assert.ErrorIs(t, result.Err, ErrInvalidLongitude)
+			assert.Nil(t, result.Data)
+		case 13:
+			var internalServerError *InternalServerError
+			assert.ErrorAs(t, result.Err, &internalServerError)
+			assert.Nil(t, result.Data)
+		default:
+			assert.NoError(t, result.Err)
+			assert.Equal(t, float64(i), *result.Data.Temperature.Value)
+		}
+	}
+}
+
+func TestClient_RealtimeBatch_noErrors(t *testing.T) {
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+		w.Write([]byte(`{"temp": {"value": 3.63, "units": "C"}}`))
+	})
+
+	defer closeFunc()
+
+	c, err := NewClient("apikey", srv.Client())
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	results, err := c.RealtimeBatch([]Location{{Latitude: 52.3, Longitude: 4.9}, {Latitude: 51.9, Longitude: 4.5}}, 0, Si, Temperature)
+
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+}
+
+func TestClient_RealtimeBatchWithContext_cancelled(t *testing.T) {
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("RealtimeBatch() expected no request to be sent with a cancelled context")
+	})
+
+	defer closeFunc()
+
+	c, err := NewClient("apikey", srv.Client())
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results, err := c.RealtimeBatchWithContext(ctx, []Location{{Latitude: 52.3, Longitude: 4.9}, {Latitude: 51.9, Longitude: 4.5}}, 2, Si, Temperature)
+
+	var batchError *BatchError
+	assert.ErrorAs(t, err, &batchError)
+
+	for _, result := range results {
+		assert.ErrorIs(t, result.Err, context.Canceled)
+	}
+}
@@ -0,0 +1,159 @@
+package climacell
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// cacheCoordinatePrecision is the number of decimals the latitude and longitude are rounded
+// to in a cache key, three decimals is roughly a hundred meters
+const cacheCoordinatePrecision = 3
+
+// Cache stores the raw response bodies of the API, implementations must be safe for concurrent use
+type Cache interface {
+	// Get returns the value stored under key, ok is false when it's missing or expired
+	Get(key string) (value []byte, ok bool)
+	// Set stores the value under key for the duration of the ttl
+	Set(key string, value []byte, ttl time.Duration)
+}
+
+type cacheBypassKey struct{}
+
+// BypassCache returns a context which makes a Client skip cached responses for the request,
+// the fresh response is still stored in the cache
+func BypassCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheBypassKey{}, true)
+}
+
+func cacheBypassed(ctx context.Context) bool {
+	bypass, _ := ctx.Value(cacheBypassKey{}).(bool)
+	return bypass
+}
+
+// cacheKey returns the key under which the response of the apiRequest is cached, it consists of the
+// base URL, a fingerprint of the api key, the endpoint, the rounded coordinates, the unit, the sorted
+// fields and the endpoint specific params. Clients sharing a Cache only share responses when they use
+// the same base URL and account
+func (r apiRequest) cacheKey(baseURL, apiKey string) string {
+	location := r.location.Round(cacheCoordinatePrecision)
+	latitude := fmt.Sprintf("%.*f", cacheCoordinatePrecision, location.Latitude)
+	longitude := fmt.Sprintf("%.*f", cacheCoordinatePrecision, location.Longitude)
+
+	return strings.Join([]string{baseURL, apiKeyFingerprint(apiKey), r.key(latitude, longitude)}, "|")
+}
+
+// apiKeyFingerprint returns a short hash of the api key, so the key itself isn't stored in the cache
+func apiKeyFingerprint(apiKey string) string {
+	sum := sha256.Sum256([]byte(apiKey))
+	return hex.EncodeToString(sum[:8])
+}
+
+// key returns a key identifying the apiRequest with the provided representation of its coordinates
+func (r apiRequest) key(latitude, longitude string) string {
+	fieldNames := make([]string, 0, len(r.fields))
+
+	for _, f := range r.fields {
+		fieldNames = append(fieldNames, f.String())
+	}
+
+	sort.Strings(fieldNames)
+
+	return strings.Join([]string{
+		string(r.endpoint),
+		latitude,
+		longitude,
+		r.unit.String(),
+		strings.Join(fieldNames, ","),
+		r.params.Encode(),
+	}, "|")
+}
+
+// LRUCache is an in-memory Cache which evicts the least recently used entry once it's full
+type LRUCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  *list.List
+	index    map[string]*list.Element
+}
+
+type lruEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewLRUCache returns an LRUCache which holds at most capacity entries
+func NewLRUCache(capacity int) *LRUCache {
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	return &LRUCache{
+		capacity: capacity,
+		entries:  list.New(),
+		index:    make(map[string]*list.Element),
+	}
+}
+
+// Get returns the value stored under key, ok is false when it's missing or expired
+func (c *LRUCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.index[key]
+
+	if !ok {
+		return nil, false
+	}
+
+	entry := element.Value.(*lruEntry)
+
+	if !timeNow().Before(entry.expiresAt) {
+		c.entries.Remove(element)
+		delete(c.index, key)
+		return nil, false
+	}
+
+	c.entries.MoveToFront(element)
+
+	return entry.value, true
+}
+
+// Set stores the value under key for the duration of the ttl
+func (c *LRUCache) Set(key string, value []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := timeNow().Add(ttl)
+
+	if element, ok := c.index[key]; ok {
+		entry := element.Value.(*lruEntry)
+		entry.value = value
+		entry.expiresAt = expiresAt
+		c.entries.MoveToFront(element)
+		return
+	}
+
+	c.index[key] = c.entries.PushFront(&lruEntry{key: key, value: value, expiresAt: expiresAt})
+
+	if c.entries.Len() > c.capacity {
+		oldest := c.entries.Back()
+		c.entries.Remove(oldest)
+		delete(c.index, oldest.Value.(*lruEntry).key)
+	}
+}
+
+// Len returns the number of entries in the cache, including expired entries which haven't been evicted yet
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.entries.Len()
+}
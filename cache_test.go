@@ -0,0 +1,183 @@
+package climacell
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func Test_apiRequest_cacheKey(t *testing.T) {
+	r := apiRequest{
+		endpoint: "/v3/weather/realtime",
+		location: mockLocation,
+		unit:     Si,
+		fields:   []Field{Temperature, Humidity, CloudBase},
+	}
+
+	prefix := "https://api.climacell.co/v3|" + apiKeyFingerprint("apikey") + "|"
+	want := prefix + "/v3/weather/realtime|52.321|4.951|si|cloud_base,humidity,temp|"
+	assert.Equal(t, want, r.cacheKey("https://api.climacell.co/v3", "apikey"))
+	assert.NotContains(t, want, "apikey")
+
+	// Nearby coordinates and a different field order share the key
+	nearby := r
+	nearby.location.Latitude = 52.3208
+	nearby.fields = []Field{CloudBase, Temperature, Humidity}
+	assert.Equal(t, want, nearby.cacheKey("https://api.climacell.co/v3", "apikey"))
+
+	differentUnit := r
+	differentUnit.unit = Us
+	assert.NotEqual(t, want, differentUnit.cacheKey("https://api.climacell.co/v3", "apikey"))
+
+	withParams := r
+	withParams.params = url.Values{"timestep": []string{"5"}}
+	assert.Equal(t, prefix+"/v3/weather/realtime|52.321|4.951|si|cloud_base,humidity,temp|timestep=5", withParams.cacheKey("https://api.climacell.co/v3", "apikey"))
+
+	// Clients with a different base URL or account don't share the key
+	assert.NotEqual(t, want, r.cacheKey("https://staging.climacell.co/v3", "apikey"))
+	assert.NotEqual(t, want, r.cacheKey("https://api.climacell.co/v3", "other-apikey"))
+}
+
+func TestLRUCache(t *testing.T) {
+	now := mockNow
+	backupTimeNow := timeNow
+	timeNow = func() time.Time {
+		return now
+	}
+
+	defer func() {
+		timeNow = backupTimeNow
+	}()
+
+	cache := NewLRUCache(2)
+	cache.Set("a", []byte("1"), time.Minute)
+	cache.Set("b", []byte("2"), time.Minute)
+
+	value, ok := cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("1"), value)
+
+	// "b" is the least recently used entry and gets evicted
+	cache.Set("c", []byte("3"), time.Minute)
+	_, ok = cache.Get("b")
+	assert.False(t, ok)
+	assert.Equal(t, 2, cache.Len())
+
+	cache.Set("a", []byte("4"), 2*time.Minute)
+	now = now.Add(time.Minute)
+
+	_, ok = cache.Get("c")
+	assert.False(t, ok, "expected entry c to be expired")
+
+	value, ok = cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("4"), value)
+	assert.Equal(t, 1, cache.Len())
+}
+
+func TestClient_Realtime_cache(t *testing.T) {
+	var requests int32
+
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(200)
+		w.Write([]byte(`{"temp": {"value": 3.63, "units": "C"}}`))
+	})
+
+	defer closeFunc()
+
+	c, err := NewClientWithOptions("apikey", WithHTTPClient(srv.Client()), WithCache(NewLRUCache(10), time.Minute))
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	for i := 0; i < 3; i++ {
+		resp, err := c.Realtime(mockLocation, Si, Temperature)
+		assert.NoError(t, err)
+		assert.Equal(t, 3.63, *resp.Temperature.Value)
+	}
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	_, err = c.RealtimeWithContext(BypassCache(context.Background()), mockLocation, Si, Temperature)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+
+	_, err = c.Realtime(mockLocation, Us, Temperature)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+}
+
+func TestClient_Realtime_sharedCache(t *testing.T) {
+	var requests int32
+
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(200)
+		w.Write([]byte(`{"temp": {"value": 3.63, "units": "C"}}`))
+	})
+
+	defer closeFunc()
+
+	cache := NewLRUCache(10)
+
+	production, err := NewClientWithOptions("apikey", WithHTTPClient(srv.Client()), WithCache(cache, time.Minute))
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	staging, err := NewClientWithOptions("apikey", WithHTTPClient(srv.Client()), WithCache(cache, time.Minute), WithBaseURL(srv.URL+"/staging"))
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	_, err = production.Realtime(mockLocation, Si, Temperature)
+	assert.NoError(t, err)
+	_, err = staging.Realtime(mockLocation, Si, Temperature)
+	assert.NoError(t, err)
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+	assert.Equal(t, 2, cache.Len())
+}
+
+func TestClient_Realtime_cacheSkipsErrors(t *testing.T) {
+	var requests int32
+
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(500)
+		w.Write([]byte(`{"message": "mock error message"}`))
+	})
+
+	defer closeFunc()
+
+	c, err := NewClientWithOptions("apikey", WithHTTPClient(srv.Client()), WithCache(NewLRUCache(10), time.Minute))
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err := c.Realtime(mockLocation, Si, Temperature)
+		assert.Error(t, err)
+	}
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestWithCache_invalid(t *testing.T) {
+	c, err := NewClientWithOptions("apikey", WithCache(nil, time.Minute))
+	assert.ErrorIs(t, err, ErrInvalidOption)
+	assert.Nil(t, c)
+
+	c, err = NewClientWithOptions("apikey", WithCache(NewLRUCache(1), 0))
+	assert.ErrorIs(t, err, ErrInvalidOption)
+	assert.Nil(t, c)
+}
@@ -1,7 +1,12 @@
 package climacell
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -12,19 +17,50 @@ func init() {
 	BaseURL = "https://api.climacell.co/v3"
 }
 
+// defaultTimeout is the timeout of the http.Client created when no http.Client is provided
+const defaultTimeout = 15 * time.Second
+
+// defaultAPIKeyHeader is the request header in which the api key is sent
+const defaultAPIKeyHeader = "apikey"
+
 // Client represents the climacell API client
 type Client struct {
-	httpClient *http.Client
-	baseURL    string
-	apiKey     string
+	httpClient        *http.Client
+	baseURL           string
+	apiKey            string
+	apiKeyHeader      string
+	userAgent         string
+	timeout           time.Duration
+	retryPolicy       RetryPolicy
+	rateLimiter       *rateLimiter
+	quotaCallback     QuotaCallback
+	cache             Cache
+	cacheTTL          time.Duration
+	flights           *flightGroup
+	skipCoverageCheck bool
 }
 
 // NewClient returns a new climacell Client and checks for the
 // validity of the provided baseURL
 func NewClient(apiKey string, httpClient *http.Client) (*Client, error) {
-	client := &Client{}
+	return NewClientWithOptions(apiKey, WithHTTPClient(httpClient))
+}
+
+// NewClientWithOptions returns a new climacell Client configured with the provided options. Without
+// the WithBaseURL option the package level BaseURL is used
+func NewClientWithOptions(apiKey string, options ...Option) (*Client, error) {
+	client := &Client{
+		baseURL:      BaseURL,
+		apiKeyHeader: defaultAPIKeyHeader,
+	}
 
-	if BaseURL == "" {
+	for _, option := range options {
+		if err := option(client); err != nil {
+			return nil, err
+		}
+	}
+
+	if client.baseURL == "" {
 		return nil, ErrInvalidBaseURL
 	}
 
@@ -32,16 +68,182 @@ func NewClient(apiKey string, httpClient *http.Client) (*Client, error) {
 		return nil, ErrInvalidAPIKey
 	}
 
-	client.baseURL = BaseURL
 	client.apiKey = apiKey
 
-	if httpClient == nil {
-		httpClient = &http.Client{
-			Timeout: 15 * time.Second,
+	if client.httpClient == nil {
+		timeout := client.timeout
+
+		if timeout == 0 {
+			timeout = defaultTimeout
 		}
-	}
 
-	client.httpClient = httpClient
+		client.httpClient = &http.Client{
+			Timeout: timeout,
+		}
+	} else if client.timeout != 0 {
+		// Copy the provided http.Client so the timeout doesn't leak to other users of it
+		httpClient := *client.httpClient
+		httpClient.Timeout = client.timeout
+		client.httpClient = &httpClient
+	}
 
 	return client, nil
 }
+
+// apiRequest contains the query parameters shared by all climacell endpoints,
+// endpoint specific parameters are stored in params
+type apiRequest struct {
+	endpoint Endpoint
+	location Location
+	unit     unit
+	fields   []Field
+	params   url.Values
+}
+
+// get calls the endpoint of the provided apiRequest and decodes the response body into v
+func (c *Client) get(ctx context.Context, r apiRequest, v interface{}) error {
+	body, err := c.fetch(ctx, r)
+
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, v)
+}
+
+// fetch returns the response body of the provided apiRequest, from the cache when possible
+func (c *Client) fetch(ctx context.Context, r apiRequest) ([]byte, error) {
+	if c.cache == nil {
+		return c.coalescedSend(ctx, r)
+	}
+
+	key := r.cacheKey(c.baseURL, c.apiKey)
+
+	if !cacheBypassed(ctx) {
+		if body, ok := c.cache.Get(key); ok {
+			return body, nil
+		}
+	}
+
+	body, err := c.coalescedSend(ctx, r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.Set(key, body, c.cacheTTL)
+
+	return body, nil
+}
+
+// coalescedSend sends the apiRequest, concurrent identical requests share a single
+// round trip when request coalescing is enabled
+func (c *Client) coalescedSend(ctx context.Context, r apiRequest) ([]byte, error) {
+	if c.flights == nil {
+		return c.send(ctx, r)
+	}
+
+	return c.flights.do(ctx, r.coalesceKey(), func() ([]byte, error) {
+		return c.send(ctx, r)
+	})
+}
+
+// send calls the endpoint of the provided apiRequest and returns the response body,
+// failed requests are retried according to the retry policy of the Client
+func (c *Client) send(ctx context.Context, r apiRequest) ([]byte, error) {
+	u, err := getURL(c.baseURL, string(r.endpoint))
+
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	q.Set("lat", floatToString(r.location.Latitude))
+	q.Set("lon", floatToString(r.location.Longitude))
+	q.Set("unit_system", r.unit.String())
+	q.Set("fields", joinFields(r.fields, ","))
+
+	for key, values := range r.params {
+		q[key] = values
+	}
+
+	u.RawQuery = q.Encode()
+
+	for attempt := 1; ; attempt++ {
+		if c.rateLimiter != nil {
+			if err := c.rateLimiter.wait(ctx); err != nil {
+				return nil, c.attemptError(attempt, err)
+			}
+		}
+
+		resp, err := c.do(ctx, u.String())
+
+		if err != nil {
+			return nil, c.attemptError(attempt, err)
+		}
+
+		quota := parseQuotaInfo(resp.Header)
+
+		if quota != nil && c.quotaCallback != nil {
+			c.quotaCallback(r.endpoint, *quota)
+		}
+
+		if c.retryPolicy.retryable(resp.StatusCode) && attempt < c.retryPolicy.MaxAttempts {
+			resp.Body.Close()
+
+			if err := sleep(ctx, c.retryPolicy.delay(attempt, resp.Header)); err != nil {
+				return nil, c.attemptError(attempt, err)
+			}
+
+			continue
+		}
+
+		err = checkHTTPError(resp, string(r.endpoint))
+
+		var tooManyRequestsError *TooManyRequestsError
+
+		if errors.As(err, &tooManyRequestsError) {
+			tooManyRequestsError.Quota = quota
+		}
+
+		if err != nil {
+			return nil, c.attemptError(attempt, err)
+		}
+
+		defer resp.Body.Close()
+
+		return ioutil.ReadAll(resp.Body)
+	}
+}
+
+// do sends a single GET request to the provided url
+func (c *Client) do(ctx context.Context, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeyHeader := c.apiKeyHeader
+
+	if apiKeyHeader == "" {
+		apiKeyHeader = defaultAPIKeyHeader
+	}
+
+	req.Header.Set(apiKeyHeader, c.apiKey)
+
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	return c.httpClient.Do(req)
+}
+
+// attemptError wraps the error in a RetryError when the request has been retried
+func (c *Client) attemptError(attempt int, err error) error {
+	if attempt > 1 {
+		return &RetryError{Attempts: attempt, Err: err}
+	}
+
+	return err
+}
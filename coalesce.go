@@ -0,0 +1,82 @@
+package climacell
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// errFlightPanicked is returned to the callers waiting for a flight whose request panicked
+var errFlightPanicked = errors.New("coalesced request panicked")
+
+// flight is an in-flight request whose result is shared with every caller waiting for it
+type flight struct {
+	done chan struct{}
+	body []byte
+	err  error
+	// cancelled reports whether the context of the caller that sent the request was done when it failed
+	cancelled bool
+}
+
+// flightGroup deduplicates concurrent requests with the same key, only the first caller sends
+// the request and the others wait for its result
+type flightGroup struct {
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+// do calls fn once for all concurrent callers with the same key. A waiting caller returns
+// early when its own context is done. The request is bound to the context of the first caller,
+// when it fails because that context is done the waiters whose context is still live try again
+func (g *flightGroup) do(ctx context.Context, key string, fn func() ([]byte, error)) ([]byte, error) {
+	for {
+		g.mu.Lock()
+
+		if g.flights == nil {
+			g.flights = make(map[string]*flight)
+		}
+
+		f, ok := g.flights[key]
+
+		if !ok {
+			break
+		}
+
+		g.mu.Unlock()
+
+		select {
+		case <-f.done:
+			if f.cancelled && ctx.Err() == nil {
+				continue
+			}
+
+			return f.body, f.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	f := &flight{done: make(chan struct{}), err: errFlightPanicked}
+	g.flights[key] = f
+	g.mu.Unlock()
+
+	// The waiters are released and the key removed even when fn panics, e.g. in the quota callback
+	defer func() {
+		g.mu.Lock()
+		delete(g.flights, key)
+		g.mu.Unlock()
+
+		close(f.done)
+	}()
+
+	f.body, f.err = fn()
+	f.cancelled = f.err != nil && ctx.Err() != nil
+
+	return f.body, f.err
+}
+
+// coalesceKey returns the key under which concurrent identical requests are deduplicated, unlike
+// the cacheKey it uses the exact coordinates
+func (r apiRequest) coalesceKey() string {
+	return r.key(floatToString(r.location.Latitude), floatToString(r.location.Longitude))
+}
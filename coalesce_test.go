@@ -0,0 +1,226 @@
+package climacell
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func Test_flightGroup_do(t *testing.T) {
+	var g flightGroup
+	var calls int32
+	release := make(chan struct{})
+	wantErr := errors.New("mock error")
+
+	var wg sync.WaitGroup
+	errs := make([]error, 10)
+
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = g.do(context.Background(), "key", func() ([]byte, error) {
+				atomic.AddInt32(&calls, 1)
+				<-release
+				return nil, wantErr
+			})
+		}(i)
+	}
+
+	// Give the goroutines time to join the flight before it completes
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	for _, err := range errs {
+		assert.Equal(t, wantErr, err)
+	}
+
+	// Once completed the next call starts a new flight
+	body, err := g.do(context.Background(), "key", func() ([]byte, error) {
+		return []byte("ok"), nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("ok"), body)
+}
+
+func Test_flightGroup_doPanic(t *testing.T) {
+	var g flightGroup
+	started := make(chan struct{})
+	release := make(chan struct{})
+	waiterErr := make(chan error)
+
+	go func() {
+		defer func() {
+			assert.Equal(t, "mock panic", recover())
+		}()
+
+		g.do(context.Background(), "key", func() ([]byte, error) {
+			close(started)
+			<-release
+			panic("mock panic")
+		})
+	}()
+
+	<-started
+
+	go func() {
+		_, err := g.do(context.Background(), "key", func() ([]byte, error) {
+			t.Error("waiter shouldn't start a new flight")
+			return nil, nil
+		})
+		waiterErr <- err
+	}()
+
+	// Give the waiter time to join the flight before it panics
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+
+	select {
+	case err := <-waiterErr:
+		assert.ErrorIs(t, err, errFlightPanicked)
+	case <-time.After(time.Second):
+		t.Fatal("waiter blocked after the leader panicked")
+	}
+
+	g.mu.Lock()
+	assert.Empty(t, g.flights)
+	g.mu.Unlock()
+
+	body, err := g.do(context.Background(), "key", func() ([]byte, error) {
+		return []byte("ok"), nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("ok"), body)
+}
+
+func Test_flightGroup_doContextDone(t *testing.T) {
+	var g flightGroup
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	defer close(release)
+
+	go g.do(context.Background(), "key", func() ([]byte, error) {
+		close(started)
+		<-release
+		return nil, nil
+	})
+
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := g.do(ctx, "key", func() ([]byte, error) {
+		t.Error("do() expected fn not to be called for a waiting caller")
+		return nil, nil
+	})
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func Test_flightGroup_doLeaderCancelled(t *testing.T) {
+	var g flightGroup
+	started := make(chan struct{})
+	leaderErr := make(chan error)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		_, err := g.do(ctx, "key", func() ([]byte, error) {
+			close(started)
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+		leaderErr <- err
+	}()
+
+	<-started
+
+	waiterBody := make(chan []byte)
+
+	go func() {
+		body, err := g.do(context.Background(), "key", func() ([]byte, error) {
+			return []byte("ok"), nil
+		})
+		assert.NoError(t, err)
+		waiterBody <- body
+	}()
+
+	// Give the waiter time to join the flight before the leader cancels it
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	assert.ErrorIs(t, <-leaderErr, context.Canceled)
+
+	select {
+	case body := <-waiterBody:
+		assert.Equal(t, []byte("ok"), body)
+	case <-time.After(time.Second):
+		t.Fatal("waiter blocked after the leader cancelled")
+	}
+}
+
+func Test_apiRequest_coalesceKey(t *testing.T) {
+	r := apiRequest{
+		endpoint: "/v3/weather/realtime",
+		location: mockLocation,
+		unit:     Si,
+		fields:   []Field{Temperature, Humidity},
+	}
+
+	assert.Equal(t, "/v3/weather/realtime|52.32123456789|4.9512456789|si|humidity,temp|", r.coalesceKey())
+}
+
+func TestClient_Realtime_coalescing(t *testing.T) {
+	var requests int32
+	release := make(chan struct{})
+
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		<-release
+		w.WriteHeader(200)
+		w.Write([]byte(`{"temp": {"value": 3.63, "units": "C"}}`))
+	})
+
+	defer closeFunc()
+
+	c, err := NewClientWithOptions("apikey", WithHTTPClient(srv.Client()), WithRequestCoalescing())
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	var wg sync.WaitGroup
+	results := make([]*RealtimeData, 20)
+
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			resp, err := c.Realtime(mockLocation, Si, Temperature)
+			assert.NoError(t, err)
+			results[i] = resp
+		}(i)
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	for _, resp := range results {
+		assert.Equal(t, 3.63, *resp.Temperature.Value)
+	}
+}
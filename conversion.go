@@ -0,0 +1,187 @@
+package climacell
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// linearUnit is a unit which converts to the base unit of its dimension by multiplying with factor
+type linearUnit struct {
+	dimension string
+	factor    float64
+}
+
+// linearUnits are the units of the API, and a few common alternatives, with their factor to the
+// base unit of their dimension
+var linearUnits = map[string]linearUnit{
+	"m/s":   {dimension: "speed", factor: 1},
+	"mph":   {dimension: "speed", factor: 0.44704},
+	"km/h":  {dimension: "speed", factor: 1 / 3.6},
+	"kn":    {dimension: "speed", factor: 1852.0 / 3600},
+	"hPa":   {dimension: "pressure", factor: 1},
+	"mbar":  {dimension: "pressure", factor: 1},
+	"inHg":  {dimension: "pressure", factor: 33.863886666667},
+	"mmHg":  {dimension: "pressure", factor: 1.333223874},
+	"m":     {dimension: "distance", factor: 1},
+	"km":    {dimension: "distance", factor: 1000},
+	"mi":    {dimension: "distance", factor: 1609.344},
+	"ft":    {dimension: "distance", factor: 0.3048},
+	"mm/hr": {dimension: "precipitation rate", factor: 1},
+	"in/hr": {dimension: "precipitation rate", factor: 25.4},
+	"mm":    {dimension: "precipitation", factor: 1},
+	"cm":    {dimension: "precipitation", factor: 10},
+	"in":    {dimension: "precipitation", factor: 25.4},
+}
+
+// toCelsius converts the temperature to degrees Celsius
+var toCelsius = map[string]func(float64) float64{
+	"C": func(v float64) float64 { return v },
+	"F": func(v float64) float64 { return (v - 32) * 5 / 9 },
+	"K": func(v float64) float64 { return v - 273.15 },
+}
+
+// fromCelsius converts the temperature in degrees Celsius to the unit
+var fromCelsius = map[string]func(float64) float64{
+	"C": func(v float64) float64 { return v },
+	"F": func(v float64) float64 { return v*9/5 + 32 },
+	"K": func(v float64) float64 { return v + 273.15 },
+}
+
+// Convert converts the value from one unit to another, e.g. Convert(10, "m/s", "mph"). The units
+// are the strings used in FloatData.Units and IntData.Units
+func Convert(value float64, from, to string) (float64, error) {
+	if from == to {
+		return value, nil
+	}
+
+	if toBase, ok := toCelsius[from]; ok {
+		if fromBase, ok := fromCelsius[to]; ok {
+			return fromBase(toBase(value)), nil
+		}
+	}
+
+	fromUnit, fromOk := linearUnits[from]
+	toUnit, toOk := linearUnits[to]
+
+	if !fromOk || !toOk || fromUnit.dimension != toUnit.dimension {
+		return 0, fmt.Errorf("%w: %q to %q", ErrUnsupportedConversion, from, to)
+	}
+
+	return value * fromUnit.factor / toUnit.factor, nil
+}
+
+// Convert returns a copy of the FloatData converted to the provided unit
+func (d *FloatData) Convert(to string) (*FloatData, error) {
+	if d.Value == nil {
+		if _, err := Convert(0, d.Units, to); err != nil {
+			return nil, err
+		}
+
+		return &FloatData{Units: to}, nil
+	}
+
+	value, err := Convert(*d.Value, d.Units, to)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &FloatData{Value: &value, Units: to}, nil
+}
+
+// Convert returns a copy of the IntData converted to the provided unit, the converted value is
+// rounded to the nearest integer
+func (d *IntData) Convert(to string) (*IntData, error) {
+	if d.Value == nil {
+		if _, err := Convert(0, d.Units, to); err != nil {
+			return nil, err
+		}
+
+		return &IntData{Units: to}, nil
+	}
+
+	value, err := Convert(float64(*d.Value), d.Units, to)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rounded := int(math.Round(value))
+
+	return &IntData{Value: &rounded, Units: to}, nil
+}
+
+// unitSystemUnits returns the units of the unit system per unit of the other unit system,
+// based on the Si and Us units in the field registry
+func unitSystemUnits(u unit) map[string]string {
+	units := make(map[string]string)
+
+	for _, info := range fieldRegistry {
+		if info.SiUnits == info.UsUnits {
+			continue
+		}
+
+		if u == Us {
+			units[info.SiUnits] = info.UsUnits
+		} else {
+			units[info.UsUnits] = info.SiUnits
+		}
+	}
+
+	return units
+}
+
+var (
+	floatDataPtrType = reflect.TypeOf(&FloatData{})
+	intDataPtrType   = reflect.TypeOf(&IntData{})
+)
+
+// ConvertTo converts all values of the RealtimeData to the units of the provided unit system
+func (d *RealtimeData) ConvertTo(u unit) error {
+	return convertValues(reflect.ValueOf(d).Elem(), unitSystemUnits(u))
+}
+
+// convertValues converts the FloatData and IntData members of the struct, including the members of
+// embedded structs, from the keys to the values of units
+func convertValues(v reflect.Value, units map[string]string) error {
+	for i := 0; i < v.NumField(); i++ {
+		member := v.Field(i)
+
+		if v.Type().Field(i).Anonymous && member.Kind() == reflect.Struct {
+			if err := convertValues(member, units); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if member.Type() != floatDataPtrType && member.Type() != intDataPtrType || member.IsNil() {
+			continue
+		}
+
+		var converted interface{}
+		var err error
+
+		switch data := member.Interface().(type) {
+		case *FloatData:
+			if to, ok := units[data.Units]; ok {
+				converted, err = data.Convert(to)
+			}
+		case *IntData:
+			if to, ok := units[data.Units]; ok {
+				converted, err = data.Convert(to)
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if converted != nil {
+			member.Set(reflect.ValueOf(converted))
+		}
+	}
+
+	return nil
+}
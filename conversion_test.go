@@ -0,0 +1,187 @@
+package climacell
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"testing"
+)
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   float64
+		from    string
+		to      string
+		want    float64
+		wantErr bool
+	}{
+		{
+			name:  "same unit",
+			value: 12.5,
+			from:  "%",
+			to:    "%",
+			want:  12.5,
+		},
+		{
+			name:  "celsius to fahrenheit",
+			value: 100,
+			from:  "C",
+			to:    "F",
+			want:  212,
+		},
+		{
+			name:  "fahrenheit to celsius",
+			value: 32,
+			from:  "F",
+			to:    "C",
+			want:  0,
+		},
+		{
+			name:  "celsius to kelvin",
+			value: 0,
+			from:  "C",
+			to:    "K",
+			want:  273.15,
+		},
+		{
+			name:  "meters per second to miles per hour",
+			value: 10,
+			from:  "m/s",
+			to:    "mph",
+			want:  22.369362920544,
+		},
+		{
+			name:  "hectopascal to inch of mercury",
+			value: 1013.25,
+			from:  "hPa",
+			to:    "inHg",
+			want:  29.921255347,
+		},
+		{
+			name:  "kilometers to miles",
+			value: 10,
+			from:  "km",
+			to:    "mi",
+			want:  6.213711922,
+		},
+		{
+			name:  "meters to feet",
+			value: 125,
+			from:  "m",
+			to:    "ft",
+			want:  410.104986877,
+		},
+		{
+			name:  "inch per hour to millimeters per hour",
+			value: 1,
+			from:  "in/hr",
+			to:    "mm/hr",
+			want:  25.4,
+		},
+		{
+			name:    "different dimensions",
+			value:   1,
+			from:    "m/s",
+			to:      "km",
+			wantErr: true,
+		},
+		{
+			name:    "unknown unit",
+			value:   1,
+			from:    "ppb",
+			to:      "ppm",
+			wantErr: true,
+		},
+		{
+			name:    "temperature to linear unit",
+			value:   1,
+			from:    "C",
+			to:      "m",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Convert(tt.value, tt.from, tt.to)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrUnsupportedConversion)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.InDelta(t, tt.want, got, 1e-6)
+		})
+	}
+}
+
+func TestFloatData_Convert(t *testing.T) {
+	value := 3.94
+	d := &FloatData{Value: &value, Units: "m/s"}
+
+	converted, err := d.Convert("km/h")
+
+	assert.NoError(t, err)
+	assert.InDelta(t, 14.184, *converted.Value, 1e-9)
+	assert.Equal(t, "km/h", converted.Units)
+	assert.Equal(t, 3.94, *d.Value)
+
+	converted, err = (&FloatData{Units: "mm/hr"}).Convert("in/hr")
+
+	assert.NoError(t, err)
+	assert.Nil(t, converted.Value)
+	assert.Equal(t, "in/hr", converted.Units)
+
+	_, err = (&FloatData{Value: &value}).Convert("mph")
+	assert.ErrorIs(t, err, ErrUnsupportedConversion)
+}
+
+func TestIntData_Convert(t *testing.T) {
+	value := 10
+	d := &IntData{Value: &value, Units: "km"}
+
+	converted, err := d.Convert("mi")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 6, *converted.Value)
+	assert.Equal(t, "mi", converted.Units)
+
+	_, err = d.Convert("C")
+	assert.ErrorIs(t, err, ErrUnsupportedConversion)
+}
+
+func TestRealtimeData_ConvertTo(t *testing.T) {
+	mockData, err := ioutil.ReadFile("mocks/realtime_response.json")
+
+	if err != nil {
+		t.Fatal("error setting up mock response file")
+	}
+
+	var d RealtimeData
+
+	if err := json.Unmarshal(mockData, &d); err != nil {
+		t.Fatal("error decoding mock response")
+	}
+
+	assert.NoError(t, d.ConvertTo(Us))
+
+	assert.InDelta(t, 38.534, *d.Temperature.Value, 1e-9)
+	assert.Equal(t, "F", d.Temperature.Units)
+	assert.Equal(t, "mph", d.WindSpeed.Units)
+	assert.Equal(t, "inHg", d.BarometricPressure.Units)
+	assert.Equal(t, "mi", d.Visibility.Units)
+	assert.Equal(t, 6, *d.Visibility.Value)
+	assert.Equal(t, "ft", d.CloudBase.Units)
+	assert.Equal(t, "in/hr", d.Precipitation.Units)
+	assert.Nil(t, d.Precipitation.Value)
+	assert.Equal(t, "%", d.Humidity.Units)
+	assert.Equal(t, "µg/m3", d.ParticulateMatter25.Units)
+
+	assert.NoError(t, d.ConvertTo(Si))
+
+	assert.InDelta(t, 3.63, *d.Temperature.Value, 1e-9)
+	assert.Equal(t, "C", d.Temperature.Units)
+	assert.InDelta(t, 3.94, *d.WindSpeed.Value, 1e-9)
+	assert.Equal(t, "m/s", d.WindSpeed.Units)
+}
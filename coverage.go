@@ -0,0 +1,105 @@
+package climacell
+
+import "fmt"
+
+// Coverage is the area, bounded by latitude and longitude, for which an endpoint returns data
+type Coverage struct {
+	MinLatitude  float64
+	MaxLatitude  float64
+	MinLongitude float64
+	MaxLongitude float64
+}
+
+// globalCoverage are the bounds of valid coordinates
+var globalCoverage = Coverage{MinLatitude: -90, MaxLatitude: 90, MinLongitude: -180, MaxLongitude: 180}
+
+// endpointCoverage contains the coverage of each endpoint, the high resolution endpoints
+// (realtime, nowcast and historical ClimaCell) don't cover the polar regions
+var endpointCoverage = map[Endpoint]Coverage{
+	RealtimeEndpoint:            {MinLatitude: -59.9, MaxLatitude: 59.9, MinLongitude: -180, MaxLongitude: 180},
+	NowcastEndpoint:             {MinLatitude: -59.9, MaxLatitude: 59.9, MinLongitude: -180, MaxLongitude: 180},
+	HistoricalClimaCellEndpoint: {MinLatitude: -59.9, MaxLatitude: 59.9, MinLongitude: -180, MaxLongitude: 180},
+	HourlyEndpoint:              globalCoverage,
+	DailyEndpoint:               globalCoverage,
+	HistoricalStationEndpoint:   globalCoverage,
+}
+
+// CoordinateError is returned when the latitude or longitude of a location is outside the
+// allowed range, either the range of valid coordinates or the coverage of an endpoint
+type CoordinateError struct {
+	// Endpoint is empty when the coordinate itself is invalid
+	Endpoint   Endpoint
+	Coordinate string
+	Value      float64
+	Min        float64
+	Max        float64
+}
+
+// Error returns the string representation of the CoordinateError
+func (e *CoordinateError) Error() string {
+	if e.Endpoint == "" {
+		return fmt.Sprintf("invalid %v %v, must be between %v and %v", e.Coordinate, e.Value, e.Min, e.Max)
+	}
+
+	return fmt.Sprintf("%v %v is outside the coverage of %v, must be between %v and %v", e.Coordinate, e.Value, e.Endpoint, e.Min, e.Max)
+}
+
+// Is makes a CoordinateError match ErrInvalidLatitude or ErrInvalidLongitude
+func (e *CoordinateError) Is(target error) bool {
+	switch e.Coordinate {
+	case "latitude":
+		return target == ErrInvalidLatitude
+	case "longitude":
+		return target == ErrInvalidLongitude
+	}
+
+	return false
+}
+
+// Contains reports whether the location is within the coverage
+func (c Coverage) Contains(location Location) bool {
+	return c.check("", location) == nil
+}
+
+// check returns a CoordinateError when the location is outside the coverage
+func (c Coverage) check(endpoint Endpoint, location Location) error {
+	if !(c.MinLatitude <= location.Latitude && location.Latitude <= c.MaxLatitude) {
+		return &CoordinateError{
+			Endpoint:   endpoint,
+			Coordinate: "latitude",
+			Value:      location.Latitude,
+			Min:        c.MinLatitude,
+			Max:        c.MaxLatitude,
+		}
+	}
+
+	if !(c.MinLongitude <= location.Longitude && location.Longitude <= c.MaxLongitude) {
+		return &CoordinateError{
+			Endpoint:   endpoint,
+			Coordinate: "longitude",
+			Value:      location.Longitude,
+			Min:        c.MinLongitude,
+			Max:        c.MaxLongitude,
+		}
+	}
+
+	return nil
+}
+
+// validateCoordinates checks the location contains valid coordinates and, unless the coverage
+// check is disabled, that the location is covered by the endpoint
+func (c *Client) validateCoordinates(endpoint Endpoint, location Location) error {
+	err := location.Validate()
+
+	if err != nil || c.skipCoverageCheck {
+		return err
+	}
+
+	coverage, ok := endpointCoverage[endpoint]
+
+	if !ok {
+		return nil
+	}
+
+	return coverage.check(endpoint, location)
+}
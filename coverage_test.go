@@ -0,0 +1,122 @@
+package climacell
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestCoordinateError_Error(t *testing.T) {
+	tests := []struct {
+		name string
+		e    *CoordinateError
+		want string
+	}{
+		{
+			name: "outside endpoint coverage",
+			e: &CoordinateError{
+				Endpoint:   "/v3/weather/realtime",
+				Coordinate: "latitude",
+				Value:      78.22,
+				Min:        -59.9,
+				Max:        59.9,
+			},
+			want: "latitude 78.22 is outside the coverage of /v3/weather/realtime, must be between -59.9 and 59.9",
+		},
+		{
+			name: "invalid coordinate",
+			e: &CoordinateError{
+				Coordinate: "longitude",
+				Value:      181,
+				Min:        -180,
+				Max:        180,
+			},
+			want: "invalid longitude 181, must be between -180 and 180",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.e.Error(); got != tt.want {
+				t.Errorf("Error() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCoordinateError_Is(t *testing.T) {
+	latitudeError := &CoordinateError{Coordinate: "latitude"}
+	longitudeError := &CoordinateError{Coordinate: "longitude"}
+
+	assert.ErrorIs(t, latitudeError, ErrInvalidLatitude)
+	assert.NotErrorIs(t, latitudeError, ErrInvalidLongitude)
+	assert.ErrorIs(t, longitudeError, ErrInvalidLongitude)
+	assert.NotErrorIs(t, longitudeError, ErrInvalidLatitude)
+}
+
+func TestCoverage_Contains(t *testing.T) {
+	coverage := endpointCoverage[RealtimeEndpoint]
+
+	assert.True(t, coverage.Contains(Location{Latitude: 59.9, Longitude: -180}))
+	assert.False(t, coverage.Contains(Location{Latitude: 78.22, Longitude: 15.65}))
+	assert.True(t, globalCoverage.Contains(Location{Latitude: 78.22, Longitude: 15.65}))
+	assert.False(t, globalCoverage.Contains(Location{Latitude: 0, Longitude: 180.01}))
+}
+
+func Test_endpointCoverage(t *testing.T) {
+	endpoints := []Endpoint{
+		RealtimeEndpoint,
+		NowcastEndpoint,
+		HourlyEndpoint,
+		DailyEndpoint,
+		HistoricalClimaCellEndpoint,
+		HistoricalStationEndpoint,
+	}
+
+	for _, endpoint := range endpoints {
+		_, ok := endpointCoverage[endpoint]
+		assert.True(t, ok, "missing coverage for %v", endpoint)
+	}
+}
+
+func TestClient_validateCoordinates(t *testing.T) {
+	svalbard := Location{Latitude: 78.22, Longitude: 15.65}
+	c := &Client{}
+
+	err := c.validateCoordinates(RealtimeEndpoint, svalbard)
+
+	var coordinateError *CoordinateError
+	if assert.ErrorAs(t, err, &coordinateError) {
+		assert.Equal(t, RealtimeEndpoint, coordinateError.Endpoint)
+		assert.Equal(t, 78.22, coordinateError.Value)
+		assert.Equal(t, 59.9, coordinateError.Max)
+	}
+
+	assert.ErrorIs(t, err, ErrInvalidLatitude)
+	assert.NoError(t, c.validateCoordinates(HourlyEndpoint, svalbard))
+	assert.ErrorIs(t, c.validateCoordinates(HourlyEndpoint, Location{Latitude: 90.1}), ErrInvalidLatitude)
+
+	c = &Client{skipCoverageCheck: true}
+	assert.NoError(t, c.validateCoordinates(RealtimeEndpoint, svalbard))
+	assert.ErrorIs(t, c.validateCoordinates(RealtimeEndpoint, Location{Latitude: 90.1}), ErrInvalidLatitude)
+}
+
+func TestClient_Realtime_withoutCoverageCheck(t *testing.T) {
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "78.22", r.URL.Query().Get("lat"))
+		w.WriteHeader(200)
+		w.Write([]byte(`{"temp": {"value": -12.5, "units": "C"}}`))
+	})
+
+	defer closeFunc()
+
+	c, err := NewClientWithOptions("apikey", WithHTTPClient(srv.Client()), WithoutCoverageCheck())
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	resp, err := c.Realtime(Location{Latitude: 78.22, Longitude: 15.65}, Si, Temperature)
+
+	assert.NoError(t, err)
+	assert.Equal(t, -12.5, *resp.Temperature.Value)
+}
@@ -0,0 +1,58 @@
+package climacell
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// DailyForecast calls the daily forecast climacell endpoint with the provided fields, a zero
+// startTime or endTime is omitted from the request so the API defaults are used
+func (c *Client) DailyForecast(location Location, unit unit, startTime, endTime time.Time, fields ...Field) ([]DailyData, error) {
+	return c.DailyForecastWithContext(context.Background(), location, unit, startTime, endTime, fields...)
+}
+
+// DailyForecastWithContext calls the daily forecast climacell endpoint with the provided fields,
+// the request is bound to the provided context
+func (c *Client) DailyForecastWithContext(ctx context.Context, location Location, unit unit, startTime, endTime time.Time, fields ...Field) ([]DailyData, error) {
+	err := c.validateDailyArgs(location, startTime, endTime, fields...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	setTimeRange(params, startTime, endTime)
+
+	var dailyData []DailyData
+
+	err = c.get(ctx, apiRequest{
+		endpoint: DailyEndpoint,
+		location: location,
+		unit:     unit,
+		fields:   fields,
+		params:   params,
+	}, &dailyData)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return dailyData, nil
+}
+
+func (c *Client) validateDailyArgs(location Location, startTime, endTime time.Time, fields ...Field) error {
+	err := c.validateCoordinates(DailyEndpoint, location)
+
+	if err != nil {
+		return err
+	}
+
+	err = validateTimeRange(startTime, endTime)
+
+	if err != nil {
+		return err
+	}
+
+	return validateFields(DailyEndpoint, fields...)
+}
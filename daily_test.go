@@ -0,0 +1,171 @@
+package climacell
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func Test_validateDailyArgs(t *testing.T) {
+	startTime := time.Date(2020, 12, 8, 0, 0, 0, 0, time.UTC)
+
+	type args struct {
+		location  Location
+		startTime time.Time
+		endTime   time.Time
+		fields    []Field
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name: "valid arguments",
+			args: args{
+				location:  Location{Latitude: 59.9, Longitude: 180},
+				startTime: startTime,
+				endTime:   startTime.Add(15 * 24 * time.Hour),
+				fields:    []Field{Temperature, PrecipitationAccumulation, MoonPhase},
+			},
+		},
+		{
+			name: "polar latitude",
+			args: args{
+				location: Location{Latitude: -89.9, Longitude: 180},
+			},
+		},
+		{
+			name: "invalid latitude",
+			args: args{
+				location: Location{Latitude: -90.01, Longitude: 180},
+			},
+			wantErr: ErrInvalidLatitude,
+		},
+		{
+			name: "invalid longitude",
+			args: args{
+				location: Location{Latitude: 59.9, Longitude: -181},
+			},
+			wantErr: ErrInvalidLongitude,
+		},
+		{
+			name: "end time before start time",
+			args: args{
+				location:  Location{Latitude: 59.9, Longitude: 180},
+				startTime: startTime,
+				endTime:   startTime.Add(-24 * time.Hour),
+			},
+			wantErr: ErrInvalidTimeRange,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&Client{}).validateDailyArgs(tt.args.location, tt.args.startTime, tt.args.endTime, tt.args.fields...)
+
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_validateDailyArgs_fieldError(t *testing.T) {
+	err := (&Client{}).validateDailyArgs(Location{Latitude: 59.9, Longitude: 180}, time.Time{}, time.Time{}, Temperature, DewPoint, ParticleMatter25)
+
+	if err == nil {
+		t.Error("(&Client{}).validateDailyArgs() error, expected err to be non nil")
+		return
+	}
+
+	want := "bad request /v3/weather/forecast/daily: invalid fields provided (dewpoint, pm25)"
+	if err.Error() != want {
+		t.Errorf("(&Client{}).validateDailyArgs() error, got = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestClient_DailyForecast(t *testing.T) {
+	mockData, err := ioutil.ReadFile("mocks/daily_response.json")
+
+	if err != nil {
+		t.Fatal("error setting up mock response file")
+		return
+	}
+
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/weather/forecast/daily", r.URL.Path)
+		assert.Equal(t, "temp,precipitation,precipitation_probability,sunrise,weather_code", r.URL.Query().Get("fields"))
+
+		w.WriteHeader(200)
+		w.Write(mockData)
+	})
+
+	defer closeFunc()
+
+	c, err := NewClient("apikey", srv.Client())
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	resp, err := c.DailyForecast(mockLocation, Si, time.Time{}, time.Time{},
+		Temperature, Precipitation, PrecipitationProbability, Sunrise, WeatherCode)
+
+	if err != nil {
+		t.Errorf("DailyForecast() error = %v, want nil", err.Error())
+		return
+	}
+
+	assert.Len(t, resp, 2)
+	assert.Equal(t, time.Date(2020, 12, 8, 0, 0, 0, 0, time.UTC), resp[0].ObservationTime.Value)
+	assert.Equal(t, 1.44, *resp[0].Temperature.Min.Value)
+	assert.Equal(t, "C", resp[0].Temperature.Min.Units)
+	assert.Equal(t, time.Date(2020, 12, 8, 6, 0, 0, 0, time.UTC), resp[0].Temperature.Min.ObservationTime)
+	assert.Equal(t, 5.5, *resp[0].Temperature.Max.Value)
+	assert.Nil(t, resp[0].Precipitation.Min)
+	assert.Equal(t, 0.4, *resp[0].Precipitation.Max.Value)
+	assert.Equal(t, float64(5), *resp[1].PrecipitationProbability.Value)
+	assert.Equal(t, "cloudy", resp[1].WeatherCode.String())
+}
+
+func TestClient_DailyForecast_non200response(t *testing.T) {
+	mockData, err := json.Marshal(HTTPError{Message: "mock error message"})
+
+	if err != nil {
+		t.Fatal("error setting up mock body")
+	}
+
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(500)
+		w.Write(mockData)
+	})
+
+	defer closeFunc()
+
+	c, err := NewClient("apikey", srv.Client())
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	resp, err := c.DailyForecast(mockLocation, Si, time.Time{}, time.Time{}, Temperature)
+
+	if err == nil {
+		t.Error("DailyForecast() error = nil, expected non nil")
+		return
+	}
+
+	wantMsg := "internal server error /v3/weather/forecast/daily: mock error message"
+
+	if err.Error() != wantMsg {
+		t.Errorf("DailyForecast() error message = %q, want %q", err.Error(), wantMsg)
+	}
+
+	assert.Nil(t, resp)
+}
@@ -0,0 +1,269 @@
+// Package derived computes meteorological quantities which are derived from the fields of a
+// climacell RealtimeData response. The calculations take the units of the response into account and
+// return nil when a required field is missing
+package derived
+
+import (
+	"github.com/marcelblijleven/climacell"
+	"math"
+)
+
+// celsius returns the temperature in degrees Celsius
+func celsius(d *climacell.FloatData) (float64, bool) {
+	return valueIn(d, "C")
+}
+
+// valueIn returns the value of the FloatData converted to the provided units
+func valueIn(d *climacell.FloatData, units string) (float64, bool) {
+	if d == nil || d.Value == nil {
+		return 0, false
+	}
+
+	value, err := climacell.Convert(*d.Value, d.Units, units)
+
+	if err != nil {
+		return 0, false
+	}
+
+	return value, true
+}
+
+// percentage returns the value of the FloatData, which is expected to be a percentage
+func percentage(d *climacell.FloatData) (float64, bool) {
+	if d == nil || d.Value == nil {
+		return 0, false
+	}
+
+	return *d.Value, true
+}
+
+// temperature returns a FloatData of the temperature in degrees Celsius, converted to the units of
+// the temperature of the response
+func temperature(data *climacell.RealtimeData, value float64) *climacell.FloatData {
+	converted, err := climacell.Convert(value, "C", data.Temperature.Units)
+
+	if err != nil {
+		return nil
+	}
+
+	return &climacell.FloatData{Value: &converted, Units: data.Temperature.Units}
+}
+
+// saturationVapourPressure returns the saturation vapour pressure in hPa at the temperature in
+// degrees Celsius, using the Magnus formula
+func saturationVapourPressure(t float64) float64 {
+	return 6.112 * math.Exp(17.62*t/(243.12+t))
+}
+
+// dewPoint returns the dew point in degrees Celsius, either from the response or calculated from the
+// temperature and humidity
+func dewPoint(data *climacell.RealtimeData) (float64, bool) {
+	if td, ok := celsius(data.DewPoint); ok {
+		return td, true
+	}
+
+	t, ok := celsius(data.Temperature)
+
+	if !ok {
+		return 0, false
+	}
+
+	rh, ok := percentage(data.Humidity)
+
+	if !ok || rh <= 0 {
+		return 0, false
+	}
+
+	gamma := math.Log(rh/100) + 17.62*t/(243.12+t)
+
+	return 243.12 * gamma / (17.62 - gamma), true
+}
+
+// DewPoint returns the dew point of the response, it's calculated from the temperature and humidity
+// when the response doesn't contain the dew point
+func DewPoint(data *climacell.RealtimeData) *climacell.FloatData {
+	if data == nil {
+		return nil
+	}
+
+	if data.DewPoint != nil && data.DewPoint.Value != nil {
+		dewPoint := *data.DewPoint
+		return &dewPoint
+	}
+
+	td, ok := dewPoint(data)
+
+	if !ok {
+		return nil
+	}
+
+	return temperature(data, td)
+}
+
+// HeatIndex returns the heat index, the temperature perceived by humans when the relative humidity is
+// taken into account, according to the US National Weather Service
+func HeatIndex(data *climacell.RealtimeData) *climacell.FloatData {
+	if data == nil {
+		return nil
+	}
+
+	t, ok := valueIn(data.Temperature, "F")
+
+	if !ok {
+		return nil
+	}
+
+	rh, ok := percentage(data.Humidity)
+
+	if !ok {
+		return nil
+	}
+
+	hi := 0.5 * (t + 61 + (t-68)*1.2 + rh*0.094)
+
+	if (hi+t)/2 >= 80 {
+		hi = -42.379 + 2.04901523*t + 10.14333127*rh - 0.22475541*t*rh - 0.00683783*t*t -
+			0.05481717*rh*rh + 0.00122874*t*t*rh + 0.00085282*t*rh*rh - 0.00000199*t*t*rh*rh
+
+		if rh < 13 && t >= 80 && t <= 112 {
+			hi -= (13 - rh) / 4 * math.Sqrt((17-math.Abs(t-95))/17)
+		} else if rh > 85 && t >= 80 && t <= 87 {
+			hi += (rh - 85) / 10 * (87 - t) / 5
+		}
+	}
+
+	c, _ := climacell.Convert(hi, "F", "C")
+
+	return temperature(data, c)
+}
+
+// WindChill returns the wind chill, the temperature perceived by humans when the wind speed is taken
+// into account, according to the US National Weather Service. The wind chill is only defined for
+// temperatures up to 10 °C and wind speeds of at least 4.8 km/h, the temperature is returned otherwise
+func WindChill(data *climacell.RealtimeData) *climacell.FloatData {
+	if data == nil {
+		return nil
+	}
+
+	t, ok := valueIn(data.Temperature, "F")
+
+	if !ok {
+		return nil
+	}
+
+	v, ok := valueIn(data.WindSpeed, "mph")
+
+	if !ok {
+		return nil
+	}
+
+	if t <= 50 && v >= 3 {
+		t = 35.74 + 0.6215*t - 35.75*math.Pow(v, 0.16) + 0.4275*t*math.Pow(v, 0.16)
+	}
+
+	c, _ := climacell.Convert(t, "F", "C")
+
+	return temperature(data, c)
+}
+
+// Humidex returns the humidex, the temperature perceived by humans when the humidity is taken into
+// account, according to the Meteorological Service of Canada
+func Humidex(data *climacell.RealtimeData) *climacell.FloatData {
+	if data == nil {
+		return nil
+	}
+
+	t, ok := celsius(data.Temperature)
+
+	if !ok {
+		return nil
+	}
+
+	td, ok := dewPoint(data)
+
+	if !ok {
+		return nil
+	}
+
+	e := 6.11 * math.Exp(5417.7530*(1/273.16-1/(td+273.15)))
+
+	return temperature(data, t+0.5555*(e-10))
+}
+
+// AbsoluteHumidity returns the mass of water vapour per volume of air in g/m3
+func AbsoluteHumidity(data *climacell.RealtimeData) *climacell.FloatData {
+	if data == nil {
+		return nil
+	}
+
+	t, ok := celsius(data.Temperature)
+
+	if !ok {
+		return nil
+	}
+
+	rh, ok := percentage(data.Humidity)
+
+	if !ok {
+		return nil
+	}
+
+	ah := saturationVapourPressure(t) * rh * 2.1674 / (273.15 + t)
+
+	return &climacell.FloatData{Value: &ah, Units: "g/m3"}
+}
+
+// CloudBase returns the estimated height of the cumulus cloud base above the ground, based on the
+// spread between the temperature and the dew point. The height is in m when the temperature of the
+// response is in °C and in ft otherwise
+func CloudBase(data *climacell.RealtimeData) *climacell.FloatData {
+	if data == nil {
+		return nil
+	}
+
+	t, ok := celsius(data.Temperature)
+
+	if !ok {
+		return nil
+	}
+
+	td, ok := dewPoint(data)
+
+	if !ok {
+		return nil
+	}
+
+	height := math.Max(t-td, 0) * 125
+	units := "m"
+
+	if data.Temperature.Units != "C" {
+		height, _ = climacell.Convert(height, "m", "ft")
+		units = "ft"
+	}
+
+	return &climacell.FloatData{Value: &height, Units: units}
+}
+
+// beaufortLimits are the lower wind speed limits in m/s of Beaufort numbers 1 to 12
+var beaufortLimits = []float64{0.5, 1.6, 3.4, 5.5, 8.0, 10.8, 13.9, 17.2, 20.8, 24.5, 28.5, 32.7}
+
+// Beaufort returns the Beaufort number, from 0 (calm) to 12 (hurricane force), of the wind speed
+func Beaufort(data *climacell.RealtimeData) *climacell.IntData {
+	if data == nil {
+		return nil
+	}
+
+	v, ok := valueIn(data.WindSpeed, "m/s")
+
+	if !ok {
+		return nil
+	}
+
+	number := 0
+
+	for number < len(beaufortLimits) && v >= beaufortLimits[number] {
+		number++
+	}
+
+	return &climacell.IntData{Value: &number, Units: "Bft"}
+}
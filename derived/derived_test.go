@@ -0,0 +1,182 @@
+package derived
+
+import (
+	"github.com/marcelblijleven/climacell"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func floatData(value float64, units string) *climacell.FloatData {
+	return &climacell.FloatData{Value: &value, Units: units}
+}
+
+func realtimeData(temperature *climacell.FloatData, humidity, windSpeed float64) *climacell.RealtimeData {
+	var data climacell.RealtimeData
+
+	data.Temperature = temperature
+	data.Humidity = floatData(humidity, "%")
+	data.WindSpeed = floatData(windSpeed, "m/s")
+
+	return &data
+}
+
+func TestDewPoint(t *testing.T) {
+	data := realtimeData(floatData(20, "C"), 50, 0)
+
+	dewPoint := DewPoint(data)
+	assert.InDelta(t, 9.26, *dewPoint.Value, 0.01)
+	assert.Equal(t, "C", dewPoint.Units)
+
+	data.DewPoint = floatData(2.56, "C")
+	assert.Equal(t, 2.56, *DewPoint(data).Value)
+
+	assert.Nil(t, DewPoint(&climacell.RealtimeData{}))
+}
+
+func TestHeatIndex(t *testing.T) {
+	tests := []struct {
+		name        string
+		temperature *climacell.FloatData
+		humidity    float64
+		want        float64
+	}{
+		{
+			name:        "hot and humid fahrenheit",
+			temperature: floatData(90, "F"),
+			humidity:    70,
+			want:        105.92,
+		},
+		{
+			name:        "hot and humid celsius",
+			temperature: floatData(32.22222, "C"),
+			humidity:    70,
+			want:        41.07,
+		},
+		{
+			name:        "mild",
+			temperature: floatData(70, "F"),
+			humidity:    50,
+			want:        69.05,
+		},
+		{
+			name:        "humid adjustment",
+			temperature: floatData(85, "F"),
+			humidity:    90,
+			want:        101.78,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := HeatIndex(realtimeData(tt.temperature, tt.humidity, 0))
+
+			assert.InDelta(t, tt.want, *got.Value, 0.01)
+			assert.Equal(t, tt.temperature.Units, got.Units)
+		})
+	}
+}
+
+func TestWindChill(t *testing.T) {
+	data := realtimeData(floatData(-10, "C"), 80, 5)
+	windChill := WindChill(data)
+	assert.InDelta(t, -17.42, *windChill.Value, 0.01)
+	assert.Equal(t, "C", windChill.Units)
+
+	data = realtimeData(floatData(15, "C"), 80, 5)
+	assert.InDelta(t, 15, *WindChill(data).Value, 1e-9, "not defined above 10 °C")
+
+	data = realtimeData(floatData(-10, "C"), 80, 0.5)
+	assert.InDelta(t, -10, *WindChill(data).Value, 1e-9, "not defined below 4.8 km/h")
+}
+
+func TestHumidex(t *testing.T) {
+	data := realtimeData(floatData(30, "C"), 0, 0)
+	data.DewPoint = floatData(15, "C")
+
+	assert.InDelta(t, 33.97, *Humidex(data).Value, 0.01)
+
+	data.DewPoint = nil
+	data.Humidity = nil
+	assert.Nil(t, Humidex(data))
+}
+
+func TestAbsoluteHumidity(t *testing.T) {
+	humidity := AbsoluteHumidity(realtimeData(floatData(68, "F"), 50, 0))
+
+	assert.InDelta(t, 8.63, *humidity.Value, 0.01)
+	assert.Equal(t, "g/m3", humidity.Units)
+}
+
+func TestCloudBase(t *testing.T) {
+	data := realtimeData(floatData(20, "C"), 0, 0)
+	data.DewPoint = floatData(12, "C")
+
+	cloudBase := CloudBase(data)
+	assert.Equal(t, 1000.0, *cloudBase.Value)
+	assert.Equal(t, "m", cloudBase.Units)
+
+	data = realtimeData(floatData(68, "F"), 0, 0)
+	data.DewPoint = floatData(53.6, "F")
+
+	cloudBase = CloudBase(data)
+	assert.InDelta(t, 3280.84, *cloudBase.Value, 0.01)
+	assert.Equal(t, "ft", cloudBase.Units)
+}
+
+func TestBeaufort(t *testing.T) {
+	tests := []struct {
+		name      string
+		windSpeed *climacell.FloatData
+		want      int
+	}{
+		{
+			name:      "calm",
+			windSpeed: floatData(0.2, "m/s"),
+			want:      0,
+		},
+		{
+			name:      "gentle breeze",
+			windSpeed: floatData(3.94, "m/s"),
+			want:      3,
+		},
+		{
+			name:      "gale in mph",
+			windSpeed: floatData(40, "mph"),
+			want:      8,
+		},
+		{
+			name:      "hurricane force",
+			windSpeed: floatData(40, "m/s"),
+			want:      12,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var data climacell.RealtimeData
+			data.WindSpeed = tt.windSpeed
+
+			assert.Equal(t, tt.want, *Beaufort(&data).Value)
+		})
+	}
+}
+
+func TestMissingFields(t *testing.T) {
+	assert.Nil(t, DewPoint(nil))
+	assert.Nil(t, HeatIndex(nil))
+	assert.Nil(t, WindChill(nil))
+	assert.Nil(t, Humidex(nil))
+	assert.Nil(t, AbsoluteHumidity(nil))
+	assert.Nil(t, CloudBase(nil))
+	assert.Nil(t, Beaufort(nil))
+
+	data := &climacell.RealtimeData{}
+
+	assert.Nil(t, HeatIndex(data))
+	assert.Nil(t, WindChill(data))
+	assert.Nil(t, Humidex(data))
+	assert.Nil(t, AbsoluteHumidity(data))
+	assert.Nil(t, CloudBase(data))
+	assert.Nil(t, Beaufort(data))
+
+	data.Temperature = &climacell.FloatData{Units: "C"}
+	assert.Nil(t, HeatIndex(data), "nil value")
+}
@@ -0,0 +1,404 @@
+package climacell
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// enumNames are the API values of an enum, indexed by the enum value. Index 0 is the unknown sentinel
+type enumNames []string
+
+// parse returns the enum value of the json string, unknown values and null map to 0
+func (n enumNames) parse(b []byte) int {
+	var s string
+
+	if err := json.Unmarshal(b, &s); err != nil {
+		return 0
+	}
+
+	for i := 1; i < len(n); i++ {
+		if strings.EqualFold(n[i], s) {
+			return i
+		}
+	}
+
+	return 0
+}
+
+// index returns v when it's a known enum value, otherwise 0
+func (n enumNames) index(v int) int {
+	if v <= 0 || v >= len(n) {
+		return 0
+	}
+
+	return v
+}
+
+// name returns the API value of the enum value
+func (n enumNames) name(v int) string {
+	return n[n.index(v)]
+}
+
+// marshal returns the json representation of the enum value, the unknown sentinel is marshalled as null
+func (n enumNames) marshal(v int) ([]byte, error) {
+	if n.index(v) == 0 {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(n[v])
+}
+
+// WeatherCodeValue is the prevailing weather condition, use Severity to compare conditions rather
+// than the raw values
+type WeatherCodeValue int
+
+const (
+	WeatherCodeUnknown WeatherCodeValue = iota
+	WeatherCodeClear
+	WeatherCodeMostlyClear
+	WeatherCodePartlyCloudy
+	WeatherCodeMostlyCloudy
+	WeatherCodeCloudy
+	WeatherCodeFogLight
+	WeatherCodeFog
+	WeatherCodeDrizzle
+	WeatherCodeRainLight
+	WeatherCodeRain
+	WeatherCodeRainHeavy
+	WeatherCodeThunderstorm
+	WeatherCodeFlurries
+	WeatherCodeSnowLight
+	WeatherCodeSnow
+	WeatherCodeSnowHeavy
+	WeatherCodeIcePelletsLight
+	WeatherCodeIcePellets
+	WeatherCodeIcePelletsHeavy
+	WeatherCodeFreezingDrizzle
+	WeatherCodeFreezingRainLight
+	WeatherCodeFreezingRain
+	WeatherCodeFreezingRainHeavy
+)
+
+var weatherCodeNames = enumNames{
+	"unknown",
+	"clear",
+	"mostly_clear",
+	"partly_cloudy",
+	"mostly_cloudy",
+	"cloudy",
+	"fog_light",
+	"fog",
+	"drizzle",
+	"rain_light",
+	"rain",
+	"rain_heavy",
+	"tstorm",
+	"flurries",
+	"snow_light",
+	"snow",
+	"snow_heavy",
+	"ice_pellets_light",
+	"ice_pellets",
+	"ice_pellets_heavy",
+	"freezing_drizzle",
+	"freezing_rain_light",
+	"freezing_rain",
+	"freezing_rain_heavy",
+}
+
+var weatherCodeDescriptions = []string{
+	"Unknown",
+	"Clear",
+	"Mostly clear",
+	"Partly cloudy",
+	"Mostly cloudy",
+	"Cloudy",
+	"Light fog",
+	"Fog",
+	"Drizzle",
+	"Light rain",
+	"Rain",
+	"Heavy rain",
+	"Thunderstorm",
+	"Flurries",
+	"Light snow",
+	"Snow",
+	"Heavy snow",
+	"Light ice pellets",
+	"Ice pellets",
+	"Heavy ice pellets",
+	"Freezing drizzle",
+	"Light freezing rain",
+	"Freezing rain",
+	"Heavy freezing rain",
+}
+
+// String returns the API value of the weather code, e.g. "rain_heavy"
+func (v WeatherCodeValue) String() string {
+	return weatherCodeNames.name(int(v))
+}
+
+// Description returns the human-readable description of the weather code, e.g. "Heavy rain"
+func (v WeatherCodeValue) Description() string {
+	return weatherCodeDescriptions[weatherCodeNames.index(int(v))]
+}
+
+// weatherCodeSeverities are the severities of the weather codes, from 1 (clear) to 10 (heavy freezing
+// rain), based on the hazard of the condition rather than the order of declaration
+var weatherCodeSeverities = map[WeatherCodeValue]int{
+	WeatherCodeClear:             1,
+	WeatherCodeMostlyClear:       1,
+	WeatherCodePartlyCloudy:      2,
+	WeatherCodeMostlyCloudy:      2,
+	WeatherCodeCloudy:            3,
+	WeatherCodeFogLight:          3,
+	WeatherCodeDrizzle:           4,
+	WeatherCodeRainLight:         4,
+	WeatherCodeFlurries:          4,
+	WeatherCodeFog:               5,
+	WeatherCodeRain:              5,
+	WeatherCodeSnowLight:         5,
+	WeatherCodeSnow:              6,
+	WeatherCodeIcePelletsLight:   6,
+	WeatherCodeFreezingDrizzle:   6,
+	WeatherCodeRainHeavy:         7,
+	WeatherCodeIcePellets:        7,
+	WeatherCodeFreezingRainLight: 7,
+	WeatherCodeSnowHeavy:         8,
+	WeatherCodeIcePelletsHeavy:   8,
+	WeatherCodeFreezingRain:      8,
+	WeatherCodeThunderstorm:      9,
+	WeatherCodeFreezingRainHeavy: 10,
+}
+
+// Severity returns the severity of the weather code, from 1 (clear) to 10 (heavy freezing rain).
+// Conditions with a comparable hazard share a severity, an unknown weather code has severity 0
+func (v WeatherCodeValue) Severity() int {
+	return weatherCodeSeverities[v]
+}
+
+// UnmarshalJSON unmarshalls the provided byte slice to a WeatherCodeValue, unknown values
+// map to WeatherCodeUnknown
+func (v *WeatherCodeValue) UnmarshalJSON(b []byte) error {
+	*v = WeatherCodeValue(weatherCodeNames.parse(b))
+	return nil
+}
+
+// MarshalJSON marshals the WeatherCodeValue to its API value
+func (v WeatherCodeValue) MarshalJSON() ([]byte, error) {
+	return weatherCodeNames.marshal(int(v))
+}
+
+// WeatherCodeData is a response type in which a weather code is stored
+type WeatherCodeData struct {
+	Value WeatherCodeValue `json:"value"`
+}
+
+// String represent the string value of WeatherCodeData
+func (d *WeatherCodeData) String() string {
+	return d.Value.String()
+}
+
+// PrecipitationTypeValue is the type of precipitation
+type PrecipitationTypeValue int
+
+const (
+	PrecipitationTypeUnknown PrecipitationTypeValue = iota
+	PrecipitationTypeNone
+	PrecipitationTypeRain
+	PrecipitationTypeSnow
+	PrecipitationTypeIcePellets
+	PrecipitationTypeFreezingRain
+)
+
+var precipitationTypeNames = enumNames{"unknown", "none", "rain", "snow", "ice_pellets", "freezing_rain"}
+
+var precipitationTypeDescriptions = []string{"Unknown", "No precipitation", "Rain", "Snow", "Ice pellets", "Freezing rain"}
+
+// String returns the API value of the precipitation type, e.g. "freezing_rain"
+func (v PrecipitationTypeValue) String() string {
+	return precipitationTypeNames.name(int(v))
+}
+
+// Description returns the human-readable description of the precipitation type, e.g. "Freezing rain"
+func (v PrecipitationTypeValue) Description() string {
+	return precipitationTypeDescriptions[precipitationTypeNames.index(int(v))]
+}
+
+// UnmarshalJSON unmarshalls the provided byte slice to a PrecipitationTypeValue, unknown values
+// map to PrecipitationTypeUnknown
+func (v *PrecipitationTypeValue) UnmarshalJSON(b []byte) error {
+	*v = PrecipitationTypeValue(precipitationTypeNames.parse(b))
+	return nil
+}
+
+// MarshalJSON marshals the PrecipitationTypeValue to its API value
+func (v PrecipitationTypeValue) MarshalJSON() ([]byte, error) {
+	return precipitationTypeNames.marshal(int(v))
+}
+
+// PrecipitationTypeData is a response type in which a precipitation type is stored
+type PrecipitationTypeData struct {
+	Value PrecipitationTypeValue `json:"value"`
+}
+
+// String represent the string value of PrecipitationTypeData
+func (d *PrecipitationTypeData) String() string {
+	return d.Value.String()
+}
+
+// MoonPhaseValue is the phase of the moon
+type MoonPhaseValue int
+
+const (
+	MoonPhaseUnknown MoonPhaseValue = iota
+	MoonPhaseNew
+	MoonPhaseWaxingCrescent
+	MoonPhaseFirstQuarter
+	MoonPhaseWaxingGibbous
+	MoonPhaseFull
+	MoonPhaseWaningGibbous
+	MoonPhaseLastQuarter
+	MoonPhaseWaningCrescent
+)
+
+var moonPhaseNames = enumNames{
+	"unknown",
+	"new",
+	"waxing_crescent",
+	"first_quarter",
+	"waxing_gibbous",
+	"full",
+	"waning_gibbous",
+	"last_quarter",
+	"waning_crescent",
+}
+
+var moonPhaseDescriptions = []string{
+	"Unknown",
+	"New moon",
+	"Waxing crescent",
+	"First quarter",
+	"Waxing gibbous",
+	"Full moon",
+	"Waning gibbous",
+	"Last quarter",
+	"Waning crescent",
+}
+
+// String returns the API value of the moon phase, e.g. "waxing_gibbous"
+func (v MoonPhaseValue) String() string {
+	return moonPhaseNames.name(int(v))
+}
+
+// Description returns the human-readable description of the moon phase, e.g. "Waxing gibbous"
+func (v MoonPhaseValue) Description() string {
+	return moonPhaseDescriptions[moonPhaseNames.index(int(v))]
+}
+
+// UnmarshalJSON unmarshalls the provided byte slice to a MoonPhaseValue, unknown values
+// map to MoonPhaseUnknown
+func (v *MoonPhaseValue) UnmarshalJSON(b []byte) error {
+	*v = MoonPhaseValue(moonPhaseNames.parse(b))
+	return nil
+}
+
+// MarshalJSON marshals the MoonPhaseValue to its API value
+func (v MoonPhaseValue) MarshalJSON() ([]byte, error) {
+	return moonPhaseNames.marshal(int(v))
+}
+
+// MoonPhaseData is a response type in which a moon phase is stored
+type MoonPhaseData struct {
+	Value MoonPhaseValue `json:"value"`
+}
+
+// String represent the string value of MoonPhaseData
+func (d *MoonPhaseData) String() string {
+	return d.Value.String()
+}
+
+// HealthConcernValue is the health concern of the air quality, according to either the
+// US EPA or the China MEP standard
+type HealthConcernValue int
+
+const (
+	HealthConcernUnknown HealthConcernValue = iota
+
+	// US EPA
+	HealthConcernGood
+	HealthConcernModerate
+	HealthConcernUnhealthyForSensitiveGroups
+	HealthConcernUnhealthy
+	HealthConcernVeryUnhealthy
+	HealthConcernHazardous
+
+	// China MEP
+	HealthConcernExcellent
+	HealthConcernLightlyPolluted
+	HealthConcernModeratelyPolluted
+	HealthConcernHeavilyPolluted
+	HealthConcernSeverelyPolluted
+)
+
+var healthConcernNames = enumNames{
+	"Unknown",
+	"Good",
+	"Moderate",
+	"Unhealthy for Sensitive Groups",
+	"Unhealthy",
+	"Very Unhealthy",
+	"Hazardous",
+	"Excellent",
+	"Lightly Polluted",
+	"Moderately Polluted",
+	"Heavily Polluted",
+	"Severely Polluted",
+}
+
+var healthConcernDescriptions = []string{
+	"Unknown",
+	"Air quality is satisfactory and poses little or no risk",
+	"Air quality is acceptable, unusually sensitive people should limit prolonged outdoor exertion",
+	"Sensitive groups may experience health effects",
+	"Everyone may begin to experience health effects",
+	"Health alert, everyone may experience more serious health effects",
+	"Health warning of emergency conditions",
+	"Air quality is satisfactory and poses no risk",
+	"Sensitive groups may experience mild symptoms",
+	"Sensitive groups may experience health effects, healthy people may experience irritation",
+	"Everyone may experience health effects",
+	"Health warning, everyone should avoid outdoor activity",
+}
+
+// String returns the API value of the health concern, e.g. "Unhealthy for Sensitive Groups"
+func (v HealthConcernValue) String() string {
+	return healthConcernNames.name(int(v))
+}
+
+// Description returns the human-readable description of the health concern
+func (v HealthConcernValue) Description() string {
+	return healthConcernDescriptions[healthConcernNames.index(int(v))]
+}
+
+// UnmarshalJSON unmarshalls the provided byte slice to a HealthConcernValue, unknown values
+// map to HealthConcernUnknown
+func (v *HealthConcernValue) UnmarshalJSON(b []byte) error {
+	*v = HealthConcernValue(healthConcernNames.parse(b))
+	return nil
+}
+
+// MarshalJSON marshals the HealthConcernValue to its API value
+func (v HealthConcernValue) MarshalJSON() ([]byte, error) {
+	return healthConcernNames.marshal(int(v))
+}
+
+// HealthConcernData is a response type in which a health concern is stored
+type HealthConcernData struct {
+	Value HealthConcernValue `json:"value"`
+}
+
+// String represent the string value of HealthConcernData
+func (d *HealthConcernData) String() string {
+	return d.Value.String()
+}
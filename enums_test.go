@@ -0,0 +1,147 @@
+package climacell
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestWeatherCodeData_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want WeatherCodeValue
+	}{
+		{
+			name: "known value",
+			data: `{"value": "rain_heavy"}`,
+			want: WeatherCodeRainHeavy,
+		},
+		{
+			name: "thunderstorm",
+			data: `{"value": "tstorm"}`,
+			want: WeatherCodeThunderstorm,
+		},
+		{
+			name: "unknown value",
+			data: `{"value": "volcanic_ash"}`,
+			want: WeatherCodeUnknown,
+		},
+		{
+			name: "null value",
+			data: `{"value": null}`,
+			want: WeatherCodeUnknown,
+		},
+		{
+			name: "non string value",
+			data: `{"value": 12}`,
+			want: WeatherCodeUnknown,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d WeatherCodeData
+			assert.NoError(t, json.Unmarshal([]byte(tt.data), &d))
+			assert.Equal(t, tt.want, d.Value)
+		})
+	}
+}
+
+func TestWeatherCodeValue_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(WeatherCodeData{Value: WeatherCodeFreezingRainLight})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"value": "freezing_rain_light"}`, string(data))
+
+	data, err = json.Marshal(WeatherCodeData{})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"value": null}`, string(data))
+}
+
+func TestWeatherCodeValue_Description(t *testing.T) {
+	assert.Equal(t, "Heavy rain", WeatherCodeRainHeavy.Description())
+	assert.Equal(t, "Mostly cloudy", WeatherCodeMostlyCloudy.Description())
+	assert.Equal(t, "Unknown", WeatherCodeValue(100).Description())
+	assert.Equal(t, "unknown", WeatherCodeValue(-1).String())
+}
+
+func TestWeatherCodeValue_Severity(t *testing.T) {
+	assert.Equal(t, 0, WeatherCodeUnknown.Severity())
+	assert.Equal(t, 1, WeatherCodeClear.Severity())
+	assert.Equal(t, 10, WeatherCodeFreezingRainHeavy.Severity())
+	assert.Equal(t, 0, WeatherCodeValue(100).Severity())
+	assert.Greater(t, WeatherCodeRainHeavy.Severity(), WeatherCodeRain.Severity())
+	assert.Greater(t, WeatherCodeSnow.Severity(), WeatherCodeCloudy.Severity())
+
+	// Ordering across groups
+	assert.Greater(t, WeatherCodeThunderstorm.Severity(), WeatherCodeFlurries.Severity())
+	assert.Greater(t, WeatherCodeThunderstorm.Severity(), WeatherCodeSnowHeavy.Severity())
+	assert.Greater(t, WeatherCodeRainHeavy.Severity(), WeatherCodeSnowLight.Severity())
+	assert.Greater(t, WeatherCodeFog.Severity(), WeatherCodeDrizzle.Severity())
+	assert.Greater(t, WeatherCodeFreezingRain.Severity(), WeatherCodeRain.Severity())
+	assert.Greater(t, WeatherCodeRainLight.Severity(), WeatherCodeCloudy.Severity())
+
+	for v := WeatherCodeClear; v <= WeatherCodeFreezingRainHeavy; v++ {
+		assert.NotZero(t, v.Severity(), "missing severity for %v", v)
+	}
+	assert.Equal(t, len(weatherCodeNames), len(weatherCodeDescriptions))
+}
+
+func TestPrecipitationTypeData_UnmarshalJSON(t *testing.T) {
+	var d PrecipitationTypeData
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"value": "freezing_rain"}`), &d))
+	assert.Equal(t, PrecipitationTypeFreezingRain, d.Value)
+	assert.Equal(t, "Freezing rain", d.Value.Description())
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"value": "hail"}`), &d))
+	assert.Equal(t, PrecipitationTypeUnknown, d.Value)
+	assert.Equal(t, len(precipitationTypeNames), len(precipitationTypeDescriptions))
+}
+
+func TestMoonPhaseData_UnmarshalJSON(t *testing.T) {
+	var d MoonPhaseData
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"value": "last_quarter"}`), &d))
+	assert.Equal(t, MoonPhaseLastQuarter, d.Value)
+	assert.Equal(t, "Last quarter", d.Value.Description())
+	assert.Equal(t, "last_quarter", d.String())
+	assert.Equal(t, len(moonPhaseNames), len(moonPhaseDescriptions))
+}
+
+func TestHealthConcernData_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want HealthConcernValue
+	}{
+		{
+			name: "EPA",
+			data: `{"value": "Unhealthy for Sensitive Groups"}`,
+			want: HealthConcernUnhealthyForSensitiveGroups,
+		},
+		{
+			name: "China MEP",
+			data: `{"value": "Lightly Polluted"}`,
+			want: HealthConcernLightlyPolluted,
+		},
+		{
+			name: "case-insensitive",
+			data: `{"value": "very unhealthy"}`,
+			want: HealthConcernVeryUnhealthy,
+		},
+		{
+			name: "unknown value",
+			data: `{"value": "Fine"}`,
+			want: HealthConcernUnknown,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d HealthConcernData
+			assert.NoError(t, json.Unmarshal([]byte(tt.data), &d))
+			assert.Equal(t, tt.want, d.Value)
+		})
+	}
+
+	assert.Equal(t, len(healthConcernNames), len(healthConcernDescriptions))
+}
@@ -8,8 +8,16 @@ import (
 var (
 	ErrInvalidBaseURL   = errors.New("invalid base URL provided")
 	ErrInvalidAPIKey    = errors.New("invalid api key provided")
+	ErrInvalidOption    = errors.New("invalid client option provided")
 	ErrInvalidLatitude  = errors.New("invalid latitude provided")
 	ErrInvalidLongitude = errors.New("invalid longitude provided")
+	ErrInvalidLocation  = errors.New("invalid location provided")
+	ErrInvalidTimestep  = errors.New("invalid timestep provided")
+	ErrInvalidTimeRange = errors.New("invalid time range provided")
+	ErrLookbackExceeded = errors.New("start time exceeds the maximum lookback window")
+	ErrUnknownField     = errors.New("unknown field provided")
+
+	ErrUnsupportedConversion = errors.New("unsupported unit conversion")
 )
 
 // HTTPError represents an error that was returned from the climacell API
@@ -53,9 +61,11 @@ func (e *ForbiddenError) Error() string {
 	return fmt.Sprintf("forbidden %v: %v", e.Endpoint, e.Message)
 }
 
-// TooManyRequestsError represents an HTTP error with status code 429 - too many requests
+// TooManyRequestsError represents an HTTP error with status code 429 - too many requests,
+// Quota contains the rate limit headers of the response when they were present
 type TooManyRequestsError struct {
 	HTTPError
+	Quota *QuotaInfo `json:"-"`
 }
 
 // Error returns the string representation of the TooManyRequestsError
@@ -105,7 +115,7 @@ func newForbiddenError(endpoint, message string) *ForbiddenError {
 }
 
 func newTooManyRequestError(endpoint, message string) *TooManyRequestsError {
-	return &TooManyRequestsError{HTTPError{
+	return &TooManyRequestsError{HTTPError: HTTPError{
 		Endpoint: endpoint,
 		Message:  message,
 	}}
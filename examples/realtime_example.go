@@ -15,12 +15,15 @@ func main() {
 		return
 	}
 
-	latitude := 52.369069057354665
-	longitude := 4.896479268175967
+	location, err := climacell.NewLocation(52.369069057354665, 4.896479268175967)
+
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
 
 	resp, err := c.Realtime(
-		latitude,
-		longitude,
+		location,
 		climacell.Si,
 		climacell.Temperature,
 		climacell.CloudBase,
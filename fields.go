@@ -1,10 +1,17 @@
 package climacell
 
-type field int
+import (
+	"fmt"
+	"strings"
+)
+
+// Field is a data field of the climacell API, e.g. Temperature, which can be requested from the endpoints
+// that support it
+type Field int
 
 const (
 	// Core
-	Temperature field = iota
+	Temperature Field = iota
 	FeelsLike
 	DewPoint
 	Humidity
@@ -46,6 +53,29 @@ const (
 	TreePollen
 	WeedPollen
 	GrassPollen
+	TreeAcaciaPollen
+	TreeAshPollen
+	TreeBeechPollen
+	TreeBirchPollen
+	TreeCedarPollen
+	TreeCypressPollen
+	TreeElderPollen
+	TreeElmPollen
+	TreeHemlockPollen
+	TreeHickoryPollen
+	TreeJuniperPollen
+	TreeMahoganyPollen
+	TreeMaplePollen
+	TreeMulberryPollen
+	TreeOakPollen
+	TreePinePollen
+	TreeCottonwoodPollen
+	TreeSprucePollen
+	TreeSycamorePollen
+	TreeWalnutPollen
+	TreeWillowPollen
+	WeedRagweedPollen
+	GrassGrassPollen
 
 	// Road
 	RoadRiskScore
@@ -60,54 +90,137 @@ const (
 	HailBinary
 )
 
-var fieldValues = []string{
-	"temp",
-	"feels_like",
-	"dewpoint",
-	"humidity",
-	"wind_speed",
-	"wind_direction",
-	"wind_gust",
-	"baro_pressure",
-	"precipitation",
-	"precipitation_type",
-	"precipitation_probability",
-	"precipitation_accumulation",
-	"sunrise",
-	"sunset",
-	"visibility",
-	"cloud_cover",
-	"cloud_base",
-	"cloud_ceiling",
-	"cloud_satellite",
-	"surface_shortwave_radiation",
-	"moon_phase",
-	"weather_code",
-	"weather_groups",
-	"pm25",
-	"pm10",
-	"o3",
-	"no2",
-	"co",
-	"so2",
-	"epa_aqi",
-	"epa_primary_pollutant",
-	"epa_health_concern",
-	"china_aqi",
-	"china_primary_pollutant",
-	"china_health_concern",
-	"pollen_tree",
-	"pollen_weed",
-	"pollen_grass",
-	"road_risk_score",
-	"road_risk",
-	"road_risk_confidence",
-	"road_risk_conditions",
-	"fire_index",
-	"hail_binary",
+// String returns the name of the field as used by the API
+func (f Field) String() string {
+	if !f.known() {
+		return fmt.Sprintf("Field(%d)", int(f))
+	}
+
+	return fieldRegistry[f].Name
 }
 
-// String returns the string value of the unit
-func (f field) String() string {
-	return fieldValues[f]
+// known reports whether the field is in the registry
+func (f Field) known() bool {
+	return f >= 0 && int(f) < len(fieldRegistry)
+}
+
+// ParseField returns the field with the provided API name, e.g. "temp". The name is case-insensitive
+func ParseField(s string) (Field, error) {
+	name := strings.ToLower(strings.TrimSpace(s))
+
+	for _, info := range fieldRegistry {
+		if info.Name == name {
+			return info.Field, nil
+		}
+	}
+
+	if suggestion, ok := closestFieldName(name); ok {
+		return 0, fmt.Errorf("%w: %q, did you mean %q?", ErrUnknownField, s, suggestion)
+	}
+
+	return 0, fmt.Errorf("%w: %q", ErrUnknownField, s)
+}
+
+// ParseFields returns the fields of a comma separated list of API names, e.g. "temp,humidity,pm25"
+func ParseFields(s string) ([]Field, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+
+	names := strings.Split(s, ",")
+	fields := make([]Field, 0, len(names))
+
+	for _, name := range names {
+		f, err := ParseField(name)
+
+		if err != nil {
+			return nil, err
+		}
+
+		fields = append(fields, f)
+	}
+
+	return fields, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface
+func (f Field) MarshalText() ([]byte, error) {
+	if !f.known() {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownField, int(f))
+	}
+
+	return []byte(f.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface
+func (f *Field) UnmarshalText(text []byte) error {
+	parsed, err := ParseField(string(text))
+
+	if err != nil {
+		return err
+	}
+
+	*f = parsed
+
+	return nil
+}
+
+// maxSuggestionDistance is the maximum edit distance for a field name to be suggested
+const maxSuggestionDistance = 3
+
+// closestFieldName returns the field name closest to the provided name
+func closestFieldName(name string) (string, bool) {
+	closest := ""
+	closestDistance := maxSuggestionDistance + 1
+
+	for _, info := range fieldRegistry {
+		if distance := levenshtein(name, info.Name); distance < closestDistance {
+			closest = info.Name
+			closestDistance = distance
+		}
+	}
+
+	return closest, closest != ""
+}
+
+// levenshtein returns the edit distance between a and b
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			current[j] = min3(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(rb)]
+}
+
+// min3 returns the smallest of the three provided integers
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+
+	if c < a {
+		a = c
+	}
+
+	return a
 }
@@ -1,11 +1,15 @@
 package climacell
 
-import "testing"
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
 
 func Test_field_String(t *testing.T) {
 	tests := []struct {
 		name string
-		f    field
+		f    Field
 		want string
 	}{
 		{
@@ -198,6 +202,26 @@ func Test_field_String(t *testing.T) {
 			f:    GrassPollen,
 			want: "pollen_grass",
 		},
+		{
+			name: "TreeBirchPollen",
+			f:    TreeBirchPollen,
+			want: "pollen_tree_birch",
+		},
+		{
+			name: "TreeWillowPollen",
+			f:    TreeWillowPollen,
+			want: "pollen_tree_willow",
+		},
+		{
+			name: "WeedRagweedPollen",
+			f:    WeedRagweedPollen,
+			want: "pollen_weed_ragweed",
+		},
+		{
+			name: "GrassGrassPollen",
+			f:    GrassGrassPollen,
+			want: "pollen_grass_grass",
+		},
 		{
 			name: "RoadRiskScore",
 			f:    RoadRiskScore,
@@ -228,6 +252,11 @@ func Test_field_String(t *testing.T) {
 			f:    HailBinary,
 			want: "hail_binary",
 		},
+		{
+			name: "unknown",
+			f:    Field(99),
+			want: "Field(99)",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -237,3 +266,91 @@ func Test_field_String(t *testing.T) {
 		})
 	}
 }
+
+func TestParseField(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    Field
+		wantErr string
+	}{
+		{
+			name: "api name",
+			s:    "temp",
+			want: Temperature,
+		},
+		{
+			name: "case-insensitive",
+			s:    "PM25",
+			want: ParticleMatter25,
+		},
+		{
+			name: "surrounding whitespace",
+			s:    " humidity ",
+			want: Humidity,
+		},
+		{
+			name:    "unknown with suggestion",
+			s:       "humidty",
+			wantErr: `unknown field provided: "humidty", did you mean "humidity"?`,
+		},
+		{
+			name:    "typo with suggestion",
+			s:       "wind_sped",
+			wantErr: `unknown field provided: "wind_sped", did you mean "wind_speed"?`,
+		},
+		{
+			name:    "unknown without suggestion",
+			s:       "something_else",
+			wantErr: `unknown field provided: "something_else"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseField(tt.s)
+
+			if tt.wantErr != "" {
+				assert.ErrorIs(t, err, ErrUnknownField)
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseFields(t *testing.T) {
+	fields, err := ParseFields("temp,humidity, pm25")
+	assert.NoError(t, err)
+	assert.Equal(t, []Field{Temperature, Humidity, ParticleMatter25}, fields)
+
+	fields, err = ParseFields("")
+	assert.NoError(t, err)
+	assert.Nil(t, fields)
+
+	_, err = ParseFields("temp,,humidity")
+	assert.ErrorIs(t, err, ErrUnknownField)
+}
+
+func TestField_Text(t *testing.T) {
+	config := struct {
+		Fields []Field `json:"fields"`
+	}{
+		Fields: []Field{Temperature, TreePollen},
+	}
+
+	data, err := json.Marshal(config)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"fields":["temp","pollen_tree"]}`, string(data))
+
+	config.Fields = nil
+	assert.NoError(t, json.Unmarshal([]byte(`{"fields":["Temp","pollen_tree"]}`), &config))
+	assert.Equal(t, []Field{Temperature, TreePollen}, config.Fields)
+
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"fields":["tmp"]}`), &config), ErrUnknownField)
+
+	_, err = Field(len(fieldRegistry)).MarshalText()
+	assert.ErrorIs(t, err, ErrUnknownField)
+}
@@ -0,0 +1,164 @@
+package climacell
+
+// FieldSet is an ordered set of fields, it can be passed to the Client methods as fields...
+type FieldSet []Field
+
+var (
+	allCore       = layerFields(LayerCore)
+	allAirQuality = layerFields(LayerAirQuality)
+	allPollen     = layerFields(LayerPollen)
+	allRoad       = layerFields(LayerRoad)
+	allFire       = layerFields(LayerFire)
+	allInsurance  = layerFields(LayerInsurance)
+)
+
+var (
+	basicWeatherFields  = NewFieldSet(Temperature, FeelsLike, Humidity, WindSpeed, WindDirection, Precipitation, PrecipitationType, WeatherCode)
+	windFields          = NewFieldSet(WindSpeed, WindDirection, WindGust)
+	precipitationFields = NewFieldSet(Precipitation, PrecipitationType, PrecipitationProbability, PrecipitationAccumulation)
+	cloudFields         = NewFieldSet(CloudCover, CloudBase, CloudCeiling)
+	aviationFields      = NewFieldSet(Temperature, DewPoint, WindSpeed, WindDirection, WindGust, BarometricPressure, Visibility, CloudCover, CloudBase, CloudCeiling)
+	drivingFields       = allRoad.Union(NewFieldSet(Precipitation, PrecipitationType, Visibility))
+)
+
+// AllCore returns the requestable fields of the core layer
+func AllCore() FieldSet {
+	return allCore.clone()
+}
+
+// AllAirQuality returns the requestable fields of the air quality layer
+func AllAirQuality() FieldSet {
+	return allAirQuality.clone()
+}
+
+// AllPollen returns the requestable fields of the pollen layer, including the individual species
+func AllPollen() FieldSet {
+	return allPollen.clone()
+}
+
+// AllRoad returns the requestable fields of the road layer
+func AllRoad() FieldSet {
+	return allRoad.clone()
+}
+
+// AllFire returns the requestable fields of the fire layer
+func AllFire() FieldSet {
+	return allFire.clone()
+}
+
+// AllInsurance returns the requestable fields of the insurance layer
+func AllInsurance() FieldSet {
+	return allInsurance.clone()
+}
+
+// BasicWeatherFields returns the fields for a general weather report
+func BasicWeatherFields() FieldSet {
+	return basicWeatherFields.clone()
+}
+
+// WindFields returns the wind speed, direction and gusts
+func WindFields() FieldSet {
+	return windFields.clone()
+}
+
+// PrecipitationFields returns the intensity, type, probability and accumulation of precipitation
+func PrecipitationFields() FieldSet {
+	return precipitationFields.clone()
+}
+
+// CloudFields returns the cloud cover, base and ceiling
+func CloudFields() FieldSet {
+	return cloudFields.clone()
+}
+
+// AviationFields returns the fields relevant for flight planning
+func AviationFields() FieldSet {
+	return aviationFields.clone()
+}
+
+// DrivingFields returns the road risk and the weather conditions affecting it
+func DrivingFields() FieldSet {
+	return drivingFields.clone()
+}
+
+// NewFieldSet returns a FieldSet of the provided fields, duplicates are removed
+func NewFieldSet(fields ...Field) FieldSet {
+	return FieldSet(nil).Union(fields)
+}
+
+// layerFields returns the fields of the layer which are supported by at least one endpoint
+func layerFields(layer Layer) FieldSet {
+	var fields FieldSet
+
+	for _, info := range fieldRegistry {
+		if info.Layer == layer && len(info.Endpoints) > 0 {
+			fields = append(fields, info.Field)
+		}
+	}
+
+	return fields
+}
+
+// clone returns a copy of the FieldSet which doesn't share its backing array
+func (s FieldSet) clone() FieldSet {
+	return append(FieldSet(nil), s...)
+}
+
+// Contains reports whether the field is part of the set
+func (s FieldSet) Contains(f Field) bool {
+	for _, field := range s {
+		if field == f {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Union returns a new FieldSet with the fields of s followed by the fields of others which are not in s
+func (s FieldSet) Union(others ...FieldSet) FieldSet {
+	var union FieldSet
+
+	for _, set := range append([]FieldSet{s}, others...) {
+		for _, f := range set {
+			if !union.Contains(f) {
+				union = append(union, f)
+			}
+		}
+	}
+
+	return union
+}
+
+// Minus returns a new FieldSet with the fields of s which are not in any of others
+func (s FieldSet) Minus(others ...FieldSet) FieldSet {
+	return s.filter(func(f Field) bool {
+		for _, set := range others {
+			if set.Contains(f) {
+				return false
+			}
+		}
+
+		return true
+	})
+}
+
+// For returns a new FieldSet without the fields the endpoint doesn't support
+func (s FieldSet) For(endpoint Endpoint) FieldSet {
+	return s.filter(func(f Field) bool {
+		return f.SupportedBy(endpoint)
+	})
+}
+
+// filter returns a new FieldSet with the fields of s for which keep returns true
+func (s FieldSet) filter(keep func(Field) bool) FieldSet {
+	var filtered FieldSet
+
+	for _, f := range s {
+		if keep(f) {
+			filtered = append(filtered, f)
+		}
+	}
+
+	return filtered
+}
@@ -0,0 +1,89 @@
+package climacell
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestNewFieldSet(t *testing.T) {
+	assert.Equal(t, FieldSet{Temperature, Humidity}, NewFieldSet(Temperature, Humidity, Temperature))
+	assert.Nil(t, NewFieldSet())
+}
+
+func Test_layerFields(t *testing.T) {
+	assert.Equal(t, FieldSet{TreePollen, WeedPollen, GrassPollen, TreeAcaciaPollen}, AllPollen()[:4])
+	assert.Len(t, AllPollen(), 26)
+	assert.Equal(t, FieldSet{RoadRiskScore, RoadRisk, RoadRiskConfidence, RoadRiskConditions}, AllRoad())
+	assert.Equal(t, FieldSet{FireIndex}, AllFire())
+	assert.Equal(t, FieldSet{HailBinary}, AllInsurance())
+	assert.Len(t, AllAirQuality(), 12)
+
+	assert.True(t, AllCore().Contains(Temperature))
+	assert.False(t, AllCore().Contains(CloudSatellite), "fields without endpoints aren't requestable")
+	assert.False(t, AllCore().Contains(ParticleMatter25))
+}
+
+func TestFieldSet_presetsAreCopies(t *testing.T) {
+	core := AllCore()
+	core[0] = HailBinary
+	_ = append(AllRoad()[:1], FireIndex)
+
+	assert.Equal(t, Temperature, AllCore()[0])
+	assert.Equal(t, FieldSet{RoadRiskScore, RoadRisk, RoadRiskConfidence, RoadRiskConditions}, AllRoad())
+
+	wind := WindFields()
+	wind[0] = Temperature
+	assert.Equal(t, FieldSet{WindSpeed, WindDirection, WindGust}, WindFields())
+}
+
+func TestFieldSet_Union(t *testing.T) {
+	s := FieldSet{Temperature, Humidity}
+	union := s.Union(FieldSet{Humidity, WindSpeed}, AllFire())
+
+	assert.Equal(t, FieldSet{Temperature, Humidity, WindSpeed, FireIndex}, union)
+	assert.Equal(t, FieldSet{Temperature, Humidity}, s)
+}
+
+func TestFieldSet_Minus(t *testing.T) {
+	s := FieldSet{Temperature, Humidity, WindSpeed, WindGust}
+
+	assert.Equal(t, FieldSet{Temperature, Humidity}, s.Minus(WindFields()))
+	assert.Equal(t, FieldSet{Humidity}, s.Minus(WindFields(), FieldSet{Temperature}))
+	assert.Nil(t, s.Minus(s))
+}
+
+func TestFieldSet_For(t *testing.T) {
+	assert.Equal(t, FieldSet{WindSpeed, WindDirection}, WindFields().For(DailyEndpoint))
+	assert.Equal(t, WindFields(), WindFields().For(RealtimeEndpoint))
+	assert.Nil(t, AllFire().For(NowcastEndpoint))
+
+	for _, endpoint := range allEndpoints {
+		for _, f := range AllCore().Union(AllAirQuality(), AllPollen(), AllRoad(), AllFire(), AllInsurance()).For(endpoint) {
+			assert.NoError(t, validateFields(endpoint, f))
+		}
+	}
+}
+
+func TestClient_Realtime_fieldSet(t *testing.T) {
+	var gotFields string
+
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		gotFields = r.URL.Query().Get("fields")
+		w.WriteHeader(200)
+		w.Write([]byte("{}"))
+	})
+
+	defer closeFunc()
+
+	c, err := NewClient("apikey", srv.Client())
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	_, err = c.Realtime(mockLocation, Si, AllRoad().Union(AllFire()).For(RealtimeEndpoint)...)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "road_risk_score,road_risk,road_risk_confidence,road_risk_conditions,fire_index", gotFields)
+}
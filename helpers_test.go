@@ -17,3 +17,5 @@ func setupTestServer(handlerFunc func(w http.ResponseWriter, r *http.Request)) (
 
 	return srv, closeFunc
 }
+
+var mockLocation = Location{Latitude: 52.321234567890, Longitude: 4.95124567890}
@@ -0,0 +1,104 @@
+package climacell
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// historicalClimaCellLookback is the maximum time in the past the start time of a
+// historical ClimaCell request can be
+var historicalClimaCellLookback = 6 * time.Hour
+
+// historicalStationLookback is the maximum time in the past the start time of a
+// historical station request can be
+var historicalStationLookback = 4 * 7 * 24 * time.Hour
+
+// HistoricalClimaCell calls the historical ClimaCell endpoint with the provided fields. The timestep
+// is the interval between the returned records in minutes (1 to 60), the startTime can't be further
+// in the past than 6 hours
+func (c *Client) HistoricalClimaCell(location Location, unit unit, timestep int, startTime, endTime time.Time, fields ...Field) ([]HistoricalData, error) {
+	return c.HistoricalClimaCellWithContext(context.Background(), location, unit, timestep, startTime, endTime, fields...)
+}
+
+// HistoricalClimaCellWithContext calls the historical ClimaCell endpoint with the provided fields,
+// the request is bound to the provided context
+func (c *Client) HistoricalClimaCellWithContext(ctx context.Context, location Location, unit unit, timestep int, startTime, endTime time.Time, fields ...Field) ([]HistoricalData, error) {
+	return c.historical(ctx, HistoricalClimaCellEndpoint, location, unit, timestep, startTime, endTime, fields...)
+}
+
+// HistoricalStation calls the historical station endpoint with the provided fields. The timestep
+// is the interval between the returned records in minutes (1 to 60), the startTime can't be further
+// in the past than 4 weeks
+func (c *Client) HistoricalStation(location Location, unit unit, timestep int, startTime, endTime time.Time, fields ...Field) ([]HistoricalData, error) {
+	return c.HistoricalStationWithContext(context.Background(), location, unit, timestep, startTime, endTime, fields...)
+}
+
+// HistoricalStationWithContext calls the historical station endpoint with the provided fields,
+// the request is bound to the provided context
+func (c *Client) HistoricalStationWithContext(ctx context.Context, location Location, unit unit, timestep int, startTime, endTime time.Time, fields ...Field) ([]HistoricalData, error) {
+	return c.historical(ctx, HistoricalStationEndpoint, location, unit, timestep, startTime, endTime, fields...)
+}
+
+func (c *Client) historical(ctx context.Context, endpoint Endpoint, location Location, unit unit, timestep int, startTime, endTime time.Time, fields ...Field) ([]HistoricalData, error) {
+	err := c.validateHistoricalArgs(endpoint, location, timestep, startTime, endTime, fields...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("timestep", strconv.Itoa(timestep))
+	setTimeRange(params, startTime, endTime)
+
+	var historicalData []HistoricalData
+
+	err = c.get(ctx, apiRequest{
+		endpoint: endpoint,
+		location: location,
+		unit:     unit,
+		fields:   fields,
+		params:   params,
+	}, &historicalData)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return historicalData, nil
+}
+
+func (c *Client) validateHistoricalArgs(endpoint Endpoint, location Location, timestep int, startTime, endTime time.Time, fields ...Field) error {
+	err := c.validateCoordinates(endpoint, location)
+
+	if err != nil {
+		return err
+	}
+
+	if timestep < 1 || timestep > 60 {
+		return ErrInvalidTimestep
+	}
+
+	if startTime.IsZero() || endTime.IsZero() {
+		return ErrInvalidTimeRange
+	}
+
+	err = validateTimeRange(startTime, endTime)
+
+	if err != nil {
+		return err
+	}
+
+	lookback := historicalClimaCellLookback
+
+	if endpoint == HistoricalStationEndpoint {
+		lookback = historicalStationLookback
+	}
+
+	if timeNow().Sub(startTime) > lookback {
+		return ErrLookbackExceeded
+	}
+
+	return validateFields(endpoint, fields...)
+}
@@ -0,0 +1,272 @@
+package climacell
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+var mockNow = time.Date(2020, 12, 7, 20, 0, 0, 0, time.UTC)
+
+func setupMockNow() func() {
+	backupTimeNow := timeNow
+	timeNow = func() time.Time {
+		return mockNow
+	}
+
+	return func() {
+		timeNow = backupTimeNow
+	}
+}
+
+func Test_validateHistoricalArgs(t *testing.T) {
+	defer setupMockNow()()
+
+	type args struct {
+		endpoint  Endpoint
+		location  Location
+		timestep  int
+		startTime time.Time
+		endTime   time.Time
+		fields    []Field
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name: "valid climacell arguments",
+			args: args{
+				endpoint:  HistoricalClimaCellEndpoint,
+				location:  Location{Latitude: 59.9, Longitude: 180},
+				timestep:  60,
+				startTime: mockNow.Add(-6 * time.Hour),
+				endTime:   mockNow,
+				fields:    []Field{Temperature, ParticleMatter25, FireIndex},
+			},
+		},
+		{
+			name: "valid station arguments",
+			args: args{
+				endpoint:  HistoricalStationEndpoint,
+				location:  Location{Latitude: 59.9, Longitude: 180},
+				timestep:  60,
+				startTime: mockNow.Add(-28 * 24 * time.Hour),
+				endTime:   mockNow.Add(-27 * 24 * time.Hour),
+				fields:    []Field{Temperature, Humidity},
+			},
+		},
+		{
+			name: "invalid latitude",
+			args: args{
+				endpoint:  HistoricalClimaCellEndpoint,
+				location:  Location{Latitude: -59.91, Longitude: 180},
+				timestep:  60,
+				startTime: mockNow.Add(-time.Hour),
+				endTime:   mockNow,
+			},
+			wantErr: ErrInvalidLatitude,
+		},
+		{
+			name: "invalid timestep",
+			args: args{
+				endpoint:  HistoricalClimaCellEndpoint,
+				location:  Location{Latitude: 59.9, Longitude: 180},
+				timestep:  0,
+				startTime: mockNow.Add(-time.Hour),
+				endTime:   mockNow,
+			},
+			wantErr: ErrInvalidTimestep,
+		},
+		{
+			name: "missing start time",
+			args: args{
+				endpoint: HistoricalClimaCellEndpoint,
+				location: Location{Latitude: 59.9, Longitude: 180},
+				timestep: 60,
+				endTime:  mockNow,
+			},
+			wantErr: ErrInvalidTimeRange,
+		},
+		{
+			name: "end time before start time",
+			args: args{
+				endpoint:  HistoricalClimaCellEndpoint,
+				location:  Location{Latitude: 59.9, Longitude: 180},
+				timestep:  60,
+				startTime: mockNow.Add(-time.Hour),
+				endTime:   mockNow.Add(-2 * time.Hour),
+			},
+			wantErr: ErrInvalidTimeRange,
+		},
+		{
+			name: "climacell lookback exceeded",
+			args: args{
+				endpoint:  HistoricalClimaCellEndpoint,
+				location:  Location{Latitude: 59.9, Longitude: 180},
+				timestep:  60,
+				startTime: mockNow.Add(-6*time.Hour - time.Minute),
+				endTime:   mockNow,
+			},
+			wantErr: ErrLookbackExceeded,
+		},
+		{
+			name: "station lookback exceeded",
+			args: args{
+				endpoint:  HistoricalStationEndpoint,
+				location:  Location{Latitude: 59.9, Longitude: 180},
+				timestep:  60,
+				startTime: mockNow.Add(-29 * 24 * time.Hour),
+				endTime:   mockNow,
+			},
+			wantErr: ErrLookbackExceeded,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&Client{}).validateHistoricalArgs(tt.args.endpoint, tt.args.location, tt.args.timestep, tt.args.startTime, tt.args.endTime, tt.args.fields...)
+
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_validateHistoricalArgs_fieldError(t *testing.T) {
+	defer setupMockNow()()
+
+	err := (&Client{}).validateHistoricalArgs(HistoricalStationEndpoint, Location{Latitude: 59.9, Longitude: 180}, 60, mockNow.Add(-time.Hour), mockNow, Temperature, ParticleMatter25, FireIndex)
+
+	if err == nil {
+		t.Error("(&Client{}).validateHistoricalArgs() error, expected err to be non nil")
+		return
+	}
+
+	want := "bad request /v3/weather/historical/station: invalid fields provided (pm25, fire_index)"
+	if err.Error() != want {
+		t.Errorf("(&Client{}).validateHistoricalArgs() error, got = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestClient_HistoricalClimaCell(t *testing.T) {
+	defer setupMockNow()()
+
+	mockData, err := ioutil.ReadFile("mocks/historical_response.json")
+
+	if err != nil {
+		t.Fatal("error setting up mock response file")
+		return
+	}
+
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "/v3/weather/historical/climacell", r.URL.Path)
+		assert.Equal(t, "60", q.Get("timestep"))
+		assert.Equal(t, "2020-12-07T18:00:00Z", q.Get("start_time"))
+		assert.Equal(t, "2020-12-07T19:00:00Z", q.Get("end_time"))
+
+		w.WriteHeader(200)
+		w.Write(mockData)
+	})
+
+	defer closeFunc()
+
+	c, err := NewClient("apikey", srv.Client())
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	resp, err := c.HistoricalClimaCell(mockLocation, Si, 60, mockNow.Add(-2*time.Hour), mockNow.Add(-time.Hour), Temperature, Humidity)
+
+	if err != nil {
+		t.Errorf("HistoricalClimaCell() error = %v, want nil", err.Error())
+		return
+	}
+
+	assert.Len(t, resp, 2)
+	assert.Equal(t, 2.94, *resp[0].Temperature.Value)
+	assert.Equal(t, 93.75, *resp[1].Humidity.Value)
+}
+
+func TestClient_HistoricalStation(t *testing.T) {
+	defer setupMockNow()()
+
+	mockData, err := ioutil.ReadFile("mocks/historical_response.json")
+
+	if err != nil {
+		t.Fatal("error setting up mock response file")
+		return
+	}
+
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/weather/historical/station", r.URL.Path)
+
+		w.WriteHeader(200)
+		w.Write(mockData)
+	})
+
+	defer closeFunc()
+
+	c, err := NewClient("apikey", srv.Client())
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	resp, err := c.HistoricalStation(mockLocation, Si, 60, mockNow.Add(-7*24*time.Hour), mockNow, Temperature, Humidity)
+
+	if err != nil {
+		t.Errorf("HistoricalStation() error = %v, want nil", err.Error())
+		return
+	}
+
+	assert.Len(t, resp, 2)
+	assert.Equal(t, 3.13, *resp[1].Temperature.Value)
+}
+
+func TestClient_HistoricalStation_non200response(t *testing.T) {
+	defer setupMockNow()()
+
+	mockData, err := json.Marshal(HTTPError{Message: "mock error message"})
+
+	if err != nil {
+		t.Fatal("error setting up mock body")
+	}
+
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(429)
+		w.Write(mockData)
+	})
+
+	defer closeFunc()
+
+	c, err := NewClient("apikey", srv.Client())
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	resp, err := c.HistoricalStation(mockLocation, Si, 60, mockNow.Add(-time.Hour), mockNow, Temperature)
+
+	if err == nil {
+		t.Error("HistoricalStation() error = nil, expected non nil")
+		return
+	}
+
+	wantMsg := "too many requests /v3/weather/historical/station: mock error message"
+
+	if err.Error() != wantMsg {
+		t.Errorf("HistoricalStation() error message = %q, want %q", err.Error(), wantMsg)
+	}
+
+	assert.Nil(t, resp)
+}
@@ -0,0 +1,58 @@
+package climacell
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// HourlyForecast calls the hourly forecast climacell endpoint with the provided fields, a zero
+// startTime or endTime is omitted from the request so the API defaults are used
+func (c *Client) HourlyForecast(location Location, unit unit, startTime, endTime time.Time, fields ...Field) ([]HourlyData, error) {
+	return c.HourlyForecastWithContext(context.Background(), location, unit, startTime, endTime, fields...)
+}
+
+// HourlyForecastWithContext calls the hourly forecast climacell endpoint with the provided fields,
+// the request is bound to the provided context
+func (c *Client) HourlyForecastWithContext(ctx context.Context, location Location, unit unit, startTime, endTime time.Time, fields ...Field) ([]HourlyData, error) {
+	err := c.validateHourlyArgs(location, startTime, endTime, fields...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	setTimeRange(params, startTime, endTime)
+
+	var hourlyData []HourlyData
+
+	err = c.get(ctx, apiRequest{
+		endpoint: HourlyEndpoint,
+		location: location,
+		unit:     unit,
+		fields:   fields,
+		params:   params,
+	}, &hourlyData)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return hourlyData, nil
+}
+
+func (c *Client) validateHourlyArgs(location Location, startTime, endTime time.Time, fields ...Field) error {
+	err := c.validateCoordinates(HourlyEndpoint, location)
+
+	if err != nil {
+		return err
+	}
+
+	err = validateTimeRange(startTime, endTime)
+
+	if err != nil {
+		return err
+	}
+
+	return validateFields(HourlyEndpoint, fields...)
+}
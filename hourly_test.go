@@ -0,0 +1,171 @@
+package climacell
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func Test_validateHourlyArgs(t *testing.T) {
+	startTime := time.Date(2020, 12, 7, 21, 0, 0, 0, time.UTC)
+
+	type args struct {
+		location  Location
+		startTime time.Time
+		endTime   time.Time
+		fields    []Field
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name: "valid arguments",
+			args: args{
+				location:  Location{Latitude: 59.9, Longitude: 180},
+				startTime: startTime,
+				endTime:   startTime.Add(96 * time.Hour),
+				fields:    []Field{Temperature, PrecipitationProbability},
+			},
+		},
+		{
+			name: "polar latitude",
+			args: args{
+				location: Location{Latitude: -89.9, Longitude: 180},
+			},
+		},
+		{
+			name: "invalid latitude",
+			args: args{
+				location: Location{Latitude: -90.01, Longitude: 180},
+			},
+			wantErr: ErrInvalidLatitude,
+		},
+		{
+			name: "invalid longitude",
+			args: args{
+				location: Location{Latitude: 59.9, Longitude: -181},
+			},
+			wantErr: ErrInvalidLongitude,
+		},
+		{
+			name: "end time before start time",
+			args: args{
+				location:  Location{Latitude: 59.9, Longitude: 180},
+				startTime: startTime,
+				endTime:   startTime.Add(-time.Hour),
+			},
+			wantErr: ErrInvalidTimeRange,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&Client{}).validateHourlyArgs(tt.args.location, tt.args.startTime, tt.args.endTime, tt.args.fields...)
+
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_validateHourlyArgs_fieldError(t *testing.T) {
+	err := (&Client{}).validateHourlyArgs(Location{Latitude: 59.9, Longitude: 180}, time.Time{}, time.Time{}, Temperature, PrecipitationAccumulation, WeatherGroups)
+
+	if err == nil {
+		t.Error("(&Client{}).validateHourlyArgs() error, expected err to be non nil")
+		return
+	}
+
+	want := "bad request /v3/weather/forecast/hourly: invalid fields provided (precipitation_accumulation, weather_groups)"
+	if err.Error() != want {
+		t.Errorf("(&Client{}).validateHourlyArgs() error, got = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestClient_HourlyForecast(t *testing.T) {
+	mockData, err := ioutil.ReadFile("mocks/hourly_response.json")
+
+	if err != nil {
+		t.Fatal("error setting up mock response file")
+		return
+	}
+
+	startTime := time.Date(2020, 12, 7, 21, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(time.Hour)
+
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "/v3/weather/forecast/hourly", r.URL.Path)
+		assert.Equal(t, "2020-12-07T21:00:00Z", q.Get("start_time"))
+		assert.Equal(t, "2020-12-07T22:00:00Z", q.Get("end_time"))
+		assert.Equal(t, "us", q.Get("unit_system"))
+
+		w.WriteHeader(200)
+		w.Write(mockData)
+	})
+
+	defer closeFunc()
+
+	c, err := NewClient("apikey", srv.Client())
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	resp, err := c.HourlyForecast(mockLocation, Us, startTime, endTime, Temperature, PrecipitationProbability, WeatherCode)
+
+	if err != nil {
+		t.Errorf("HourlyForecast() error = %v, want nil", err.Error())
+		return
+	}
+
+	assert.Len(t, resp, 2)
+	assert.Equal(t, 4.1, *resp[0].Temperature.Value)
+	assert.Equal(t, float64(35), *resp[1].PrecipitationProbability.Value)
+	assert.Equal(t, "drizzle", resp[1].WeatherCode.String())
+	assert.Equal(t, endTime, resp[1].ObservationTime.Value)
+}
+
+func TestClient_HourlyForecast_non200response(t *testing.T) {
+	mockData, err := json.Marshal(HTTPError{Message: "mock error message"})
+
+	if err != nil {
+		t.Fatal("error setting up mock body")
+	}
+
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(401)
+		w.Write(mockData)
+	})
+
+	defer closeFunc()
+
+	c, err := NewClient("apikey", srv.Client())
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	resp, err := c.HourlyForecast(mockLocation, Si, time.Time{}, time.Time{}, Temperature)
+
+	if err == nil {
+		t.Error("HourlyForecast() error = nil, expected non nil")
+		return
+	}
+
+	wantMsg := "unauthorized /v3/weather/forecast/hourly: mock error message"
+
+	if err.Error() != wantMsg {
+		t.Errorf("HourlyForecast() error message = %q, want %q", err.Error(), wantMsg)
+	}
+
+	assert.Nil(t, resp)
+}
@@ -0,0 +1,150 @@
+package climacell
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Location is a point on earth identified by its latitude and longitude, the optional
+// Name and ID can be used to identify the location in the results of a batch
+type Location struct {
+	Latitude  float64
+	Longitude float64
+	Name      string
+	ID        string
+}
+
+// NewLocation returns a Location after checking the latitude and longitude are valid coordinates
+func NewLocation(latitude, longitude float64) (Location, error) {
+	location := Location{Latitude: latitude, Longitude: longitude}
+
+	if err := location.Validate(); err != nil {
+		return Location{}, err
+	}
+
+	return location, nil
+}
+
+// ParseLocation parses a location in the "latitude,longitude" format, e.g. "52.37,4.89"
+func ParseLocation(s string) (Location, error) {
+	parts := strings.Split(s, ",")
+
+	if len(parts) != 2 {
+		return Location{}, fmt.Errorf("%w: expected \"latitude,longitude\", got %q", ErrInvalidLocation, s)
+	}
+
+	latitude, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+
+	if err != nil {
+		return Location{}, fmt.Errorf("%w: invalid latitude %q", ErrInvalidLocation, parts[0])
+	}
+
+	longitude, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+
+	if err != nil {
+		return Location{}, fmt.Errorf("%w: invalid longitude %q", ErrInvalidLocation, parts[1])
+	}
+
+	return NewLocation(latitude, longitude)
+}
+
+// geoJSONObject is a GeoJSON Point or a Feature with a Point geometry
+type geoJSONObject struct {
+	Type        string          `json:"type"`
+	Coordinates []float64       `json:"coordinates"`
+	Geometry    *geoJSONObject  `json:"geometry"`
+	ID          json.RawMessage `json:"id"`
+	Properties  struct {
+		Name string `json:"name"`
+	} `json:"properties"`
+}
+
+// ParseGeoJSON parses a GeoJSON Point, or a Feature with a Point geometry. The name property
+// and the id of a Feature are used as the Name and ID of the Location
+func ParseGeoJSON(data []byte) (Location, error) {
+	var object geoJSONObject
+
+	if err := json.Unmarshal(data, &object); err != nil {
+		return Location{}, fmt.Errorf("%w: %v", ErrInvalidLocation, err)
+	}
+
+	point := &object
+
+	if object.Type == "Feature" {
+		point = object.Geometry
+	}
+
+	if point == nil || point.Type != "Point" || len(point.Coordinates) < 2 {
+		return Location{}, fmt.Errorf("%w: expected a GeoJSON Point", ErrInvalidLocation)
+	}
+
+	// GeoJSON positions are ordered longitude, latitude
+	location, err := NewLocation(point.Coordinates[1], point.Coordinates[0])
+
+	if err != nil {
+		return Location{}, err
+	}
+
+	if object.Type == "Feature" {
+		location.Name = object.Properties.Name
+		location.ID, err = geoJSONID(object.ID)
+
+		if err != nil {
+			return Location{}, err
+		}
+	}
+
+	return location, nil
+}
+
+// geoJSONID returns the id of a GeoJSON Feature, which is either a string or a number. A missing or
+// null id results in an empty ID
+func geoJSONID(raw json.RawMessage) (string, error) {
+	if len(raw) == 0 {
+		return "", nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	var id interface{}
+
+	if err := decoder.Decode(&id); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidLocation, err)
+	}
+
+	switch v := id.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	default:
+		return "", fmt.Errorf("%w: expected a string or number id, got %s", ErrInvalidLocation, raw)
+	}
+}
+
+// Validate checks the latitude and longitude of the Location are valid coordinates, the
+// returned CoordinateError matches ErrInvalidLatitude or ErrInvalidLongitude
+func (l Location) Validate() error {
+	return globalCoverage.check("", l)
+}
+
+// Round returns a copy of the Location with the coordinates rounded to the provided number of decimals
+func (l Location) Round(precision int) Location {
+	p := math.Pow(10, float64(precision))
+	l.Latitude = math.Round(l.Latitude*p) / p
+	l.Longitude = math.Round(l.Longitude*p) / p
+
+	return l
+}
+
+// String returns the Location in the "latitude,longitude" format
+func (l Location) String() string {
+	return fmt.Sprintf("%v,%v", floatToString(l.Latitude), floatToString(l.Longitude))
+}
@@ -0,0 +1,211 @@
+package climacell
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestNewLocation(t *testing.T) {
+	tests := []struct {
+		name      string
+		latitude  float64
+		longitude float64
+		wantErr   error
+	}{
+		{
+			name:      "valid location",
+			latitude:  52.369069057354665,
+			longitude: 4.896479268175967,
+		},
+		{
+			name:      "valid location on the edges",
+			latitude:  -90,
+			longitude: 180,
+		},
+		{
+			name:      "invalid latitude",
+			latitude:  90.01,
+			longitude: 4.89,
+			wantErr:   ErrInvalidLatitude,
+		},
+		{
+			name:      "NaN latitude",
+			latitude:  math.NaN(),
+			longitude: 4.89,
+			wantErr:   ErrInvalidLatitude,
+		},
+		{
+			name:      "invalid longitude",
+			latitude:  52.37,
+			longitude: -180.01,
+			wantErr:   ErrInvalidLongitude,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewLocation(tt.latitude, tt.longitude)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, Location{}, got)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, Location{Latitude: tt.latitude, Longitude: tt.longitude}, got)
+		})
+	}
+}
+
+func TestParseLocation(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    Location
+		wantErr error
+	}{
+		{
+			name: "valid location",
+			s:    "52.37,4.89",
+			want: Location{Latitude: 52.37, Longitude: 4.89},
+		},
+		{
+			name: "valid location with whitespace",
+			s:    " -33.86 , 151.21 ",
+			want: Location{Latitude: -33.86, Longitude: 151.21},
+		},
+		{
+			name:    "missing longitude",
+			s:       "52.37",
+			wantErr: ErrInvalidLocation,
+		},
+		{
+			name:    "too many parts",
+			s:       "52.37,4.89,12",
+			wantErr: ErrInvalidLocation,
+		},
+		{
+			name:    "invalid number",
+			s:       "52.37,east",
+			wantErr: ErrInvalidLocation,
+		},
+		{
+			name:    "out of range",
+			s:       "91,4.89",
+			wantErr: ErrInvalidLatitude,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseLocation(tt.s)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseGeoJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    Location
+		wantErr error
+	}{
+		{
+			name: "point",
+			data: `{"type": "Point", "coordinates": [4.89, 52.37]}`,
+			want: Location{Latitude: 52.37, Longitude: 4.89},
+		},
+		{
+			name: "point with altitude",
+			data: `{"type": "Point", "coordinates": [4.89, 52.37, 2.5]}`,
+			want: Location{Latitude: 52.37, Longitude: 4.89},
+		},
+		{
+			name: "feature",
+			data: `{"type": "Feature", "id": "ams-01", "geometry": {"type": "Point", "coordinates": [4.89, 52.37]}, "properties": {"name": "Amsterdam"}}`,
+			want: Location{Latitude: 52.37, Longitude: 4.89, Name: "Amsterdam", ID: "ams-01"},
+		},
+		{
+			name: "feature with numeric id",
+			data: `{"type": "Feature", "id": 12, "geometry": {"type": "Point", "coordinates": [4.89, 52.37]}}`,
+			want: Location{Latitude: 52.37, Longitude: 4.89, ID: "12"},
+		},
+		{
+			name: "feature with large numeric id",
+			data: `{"type": "Feature", "id": 12345678901234567890, "geometry": {"type": "Point", "coordinates": [4.89, 52.37]}}`,
+			want: Location{Latitude: 52.37, Longitude: 4.89, ID: "12345678901234567890"},
+		},
+		{
+			name: "feature with null id",
+			data: `{"type": "Feature", "id": null, "geometry": {"type": "Point", "coordinates": [4.89, 52.37]}}`,
+			want: Location{Latitude: 52.37, Longitude: 4.89},
+		},
+		{
+			name: "feature with escaped id",
+			data: `{"type": "Feature", "id": "a\"b\u00e9", "geometry": {"type": "Point", "coordinates": [4.89, 52.37]}}`,
+			want: Location{Latitude: 52.37, Longitude: 4.89, ID: "a\"bé"},
+		},
+		{
+			name:    "feature with object id",
+			data:    `{"type": "Feature", "id": {"a": 1}, "geometry": {"type": "Point", "coordinates": [4.89, 52.37]}}`,
+			wantErr: ErrInvalidLocation,
+		},
+		{
+			name:    "feature without geometry",
+			data:    `{"type": "Feature", "properties": {}}`,
+			wantErr: ErrInvalidLocation,
+		},
+		{
+			name:    "polygon",
+			data:    `{"type": "Polygon", "coordinates": [[[4.89, 52.37], [4.9, 52.38], [4.89, 52.37]]]}`,
+			wantErr: ErrInvalidLocation,
+		},
+		{
+			name:    "invalid json",
+			data:    `{"type": "Point"`,
+			wantErr: ErrInvalidLocation,
+		},
+		{
+			name:    "coordinates out of range",
+			data:    `{"type": "Point", "coordinates": [52.37, 184.89]}`,
+			wantErr: ErrInvalidLatitude,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseGeoJSON([]byte(tt.data))
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLocation_Round(t *testing.T) {
+	location := Location{Latitude: 52.369069057354665, Longitude: -4.896479268175967, Name: "Amsterdam"}
+	want := Location{Latitude: 52.369, Longitude: -4.896, Name: "Amsterdam"}
+
+	assert.Equal(t, want, location.Round(3))
+}
+
+func TestLocation_String(t *testing.T) {
+	location := Location{Latitude: 52.369069057354665, Longitude: 4.896479268175967}
+	want := "52.369069057354665,4.896479268175967"
+
+	if got := location.String(); got != want {
+		t.Errorf("String() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,111 @@
+package climacell
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// fieldMembers maps the fields to the index of the struct member they're decoded into, per response type
+var fieldMembers sync.Map
+
+// members returns the index of the struct member of each field the response type decodes, based on
+// the json tags of its members and the members of its embedded structs
+func members(typ reflect.Type) map[Field][]int {
+	if cached, ok := fieldMembers.Load(typ); ok {
+		return cached.(map[Field][]int)
+	}
+
+	tags := make(map[string][]int)
+	collectTags(typ, nil, tags)
+
+	indexes := make(map[Field][]int)
+
+	for _, info := range fieldRegistry {
+		if index, ok := tags[info.Name]; ok {
+			indexes[info.Field] = index
+		}
+	}
+
+	fieldMembers.Store(typ, indexes)
+
+	return indexes
+}
+
+// collectTags adds the index of every tagged struct member to tags by json name
+func collectTags(typ reflect.Type, parent []int, tags map[string][]int) {
+	for i := 0; i < typ.NumField(); i++ {
+		member := typ.Field(i)
+		index := append(append([]int{}, parent...), i)
+
+		if member.Anonymous && member.Type.Kind() == reflect.Struct {
+			collectTags(member.Type, index, tags)
+			continue
+		}
+
+		name := strings.Split(member.Tag.Get("json"), ",")[0]
+
+		if name != "" && name != "-" {
+			tags[name] = index
+		}
+	}
+}
+
+// structMember returns the struct member the field is decoded into by the response type
+func structMember(typ reflect.Type, f Field) (reflect.StructField, bool) {
+	index, ok := members(typ)[f]
+
+	if !ok {
+		return reflect.StructField{}, false
+	}
+
+	return typ.FieldByIndex(index), true
+}
+
+// getMember returns the value of the struct member of the field, nil when the response type doesn't
+// decode the field or the member is a nil pointer
+func getMember(v reflect.Value, f Field) interface{} {
+	index, ok := members(v.Type())[f]
+
+	if !ok {
+		return nil
+	}
+
+	member := v.FieldByIndex(index)
+
+	if member.Kind() == reflect.Ptr && member.IsNil() {
+		return nil
+	}
+
+	return member.Interface()
+}
+
+// Member returns the struct member of the field, e.g. a *FloatData for Temperature. It returns nil
+// when the field isn't present in the response
+func (d *RealtimeData) Member(f Field) interface{} {
+	return getMember(reflect.ValueOf(d).Elem(), f)
+}
+
+// Member returns the struct member of the field, e.g. a *FloatData for Temperature. It returns nil
+// when the field isn't present in the response
+func (d *NowcastData) Member(f Field) interface{} {
+	return getMember(reflect.ValueOf(d).Elem(), f)
+}
+
+// Member returns the struct member of the field, e.g. a *FloatData for Temperature. It returns nil
+// when the field isn't present in the response
+func (d *HourlyData) Member(f Field) interface{} {
+	return getMember(reflect.ValueOf(d).Elem(), f)
+}
+
+// Member returns the struct member of the field, e.g. a *FloatData for Temperature. It returns nil
+// when the field isn't present in the response
+func (d *HistoricalData) Member(f Field) interface{} {
+	return getMember(reflect.ValueOf(d).Elem(), f)
+}
+
+// Member returns the struct member of the field, e.g. a *MinMaxData for Temperature. It returns nil
+// when the field isn't present in the response
+func (d *DailyData) Member(f Field) interface{} {
+	return getMember(reflect.ValueOf(d).Elem(), f)
+}
@@ -0,0 +1,86 @@
+package climacell
+
+import (
+	"github.com/stretchr/testify/assert"
+	"reflect"
+	"testing"
+)
+
+func Test_structMember(t *testing.T) {
+	member, ok := structMember(reflect.TypeOf(RealtimeData{}), DewPoint)
+
+	assert.True(t, ok)
+	assert.Equal(t, "DewPoint", member.Name)
+	assert.Equal(t, `json:"dewpoint,omitempty"`, string(member.Tag))
+
+	member, ok = structMember(reflect.TypeOf(RealtimeData{}), TreeBirchPollen)
+
+	assert.True(t, ok)
+	assert.Equal(t, "Birch", member.Name)
+
+	_, ok = structMember(reflect.TypeOf(DailyData{}), DewPoint)
+	assert.False(t, ok)
+}
+
+func TestResponseTypes_decodeAllFields(t *testing.T) {
+	responseTypes := map[Endpoint]reflect.Type{
+		RealtimeEndpoint:            reflect.TypeOf(RealtimeData{}),
+		NowcastEndpoint:             reflect.TypeOf(NowcastData{}),
+		HourlyEndpoint:              reflect.TypeOf(HourlyData{}),
+		DailyEndpoint:               reflect.TypeOf(DailyData{}),
+		HistoricalClimaCellEndpoint: reflect.TypeOf(HistoricalData{}),
+		HistoricalStationEndpoint:   reflect.TypeOf(HistoricalData{}),
+	}
+
+	for endpoint, typ := range responseTypes {
+		for _, info := range fieldRegistry {
+			if !info.SupportedBy(endpoint) {
+				continue
+			}
+
+			member, ok := structMember(typ, info.Field)
+
+			if !assert.True(t, ok, "%v doesn't decode %v", typ.Name(), info.Name) || endpoint == DailyEndpoint {
+				continue
+			}
+
+			memberType := member.Type
+
+			if memberType.Kind() == reflect.Ptr {
+				memberType = memberType.Elem()
+			}
+
+			assert.Equal(t, info.Type, memberType, "%v decodes %v into the wrong type", typ.Name(), info.Name)
+		}
+	}
+}
+
+func TestRealtimeData_Member(t *testing.T) {
+	d := mockRealtimeData(t)
+
+	temperature, ok := d.Member(Temperature).(*FloatData)
+
+	if assert.True(t, ok) {
+		assert.Equal(t, 3.63, *temperature.Value)
+	}
+
+	dewPoint, ok := d.Member(DewPoint).(*FloatData)
+
+	if assert.True(t, ok, "dewpoint should decode") {
+		assert.Equal(t, 2.56, *dewPoint.Value)
+	}
+
+	assert.IsType(t, &WeatherCodeData{}, d.Member(WeatherCode))
+	assert.IsType(t, &PollenData{}, d.Member(TreeBirchPollen))
+	assert.Nil(t, d.Member(TreeOakPollen), "not in the response")
+	assert.Nil(t, d.Member(PrecipitationProbability), "not in the response")
+	assert.Equal(t, "", d.Member(RoadRisk))
+}
+
+func TestDailyData_Member(t *testing.T) {
+	d := DailyData{PrecipitationAccumulation: &FloatData{Units: "mm"}}
+
+	assert.Equal(t, d.PrecipitationAccumulation, d.Member(PrecipitationAccumulation))
+	assert.Nil(t, d.Member(Temperature))
+	assert.Nil(t, d.Member(DewPoint), "not decoded by DailyData")
+}
@@ -0,0 +1,65 @@
+package climacell
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Nowcast calls the nowcast climacell endpoint with the provided fields. The timestep is the
+// interval between the returned records in minutes (1 to 60), a zero startTime or endTime is omitted
+// from the request so the API defaults are used
+func (c *Client) Nowcast(location Location, unit unit, timestep int, startTime, endTime time.Time, fields ...Field) ([]NowcastData, error) {
+	return c.NowcastWithContext(context.Background(), location, unit, timestep, startTime, endTime, fields...)
+}
+
+// NowcastWithContext calls the nowcast climacell endpoint with the provided fields, the request
+// is bound to the provided context
+func (c *Client) NowcastWithContext(ctx context.Context, location Location, unit unit, timestep int, startTime, endTime time.Time, fields ...Field) ([]NowcastData, error) {
+	err := c.validateNowcastArgs(location, timestep, startTime, endTime, fields...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("timestep", strconv.Itoa(timestep))
+	setTimeRange(params, startTime, endTime)
+
+	var nowcastData []NowcastData
+
+	err = c.get(ctx, apiRequest{
+		endpoint: NowcastEndpoint,
+		location: location,
+		unit:     unit,
+		fields:   fields,
+		params:   params,
+	}, &nowcastData)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return nowcastData, nil
+}
+
+func (c *Client) validateNowcastArgs(location Location, timestep int, startTime, endTime time.Time, fields ...Field) error {
+	err := c.validateCoordinates(NowcastEndpoint, location)
+
+	if err != nil {
+		return err
+	}
+
+	if timestep < 1 || timestep > 60 {
+		return ErrInvalidTimestep
+	}
+
+	err = validateTimeRange(startTime, endTime)
+
+	if err != nil {
+		return err
+	}
+
+	return validateFields(NowcastEndpoint, fields...)
+}
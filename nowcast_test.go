@@ -0,0 +1,217 @@
+package climacell
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func Test_validateNowcastArgs(t *testing.T) {
+	startTime := time.Date(2020, 12, 7, 20, 0, 0, 0, time.UTC)
+
+	type args struct {
+		location  Location
+		timestep  int
+		startTime time.Time
+		endTime   time.Time
+		fields    []Field
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name: "valid arguments",
+			args: args{
+				location:  Location{Latitude: 59.9, Longitude: 180},
+				timestep:  5,
+				startTime: startTime,
+				endTime:   startTime.Add(6 * time.Hour),
+				fields:    []Field{Temperature, Precipitation},
+			},
+		},
+		{
+			name: "valid arguments without time range",
+			args: args{
+				location: Location{Latitude: 59.9, Longitude: 180},
+				timestep: 60,
+				fields:   []Field{Temperature},
+			},
+		},
+		{
+			name: "invalid latitude",
+			args: args{
+				location: Location{Latitude: 59.91, Longitude: 180},
+				timestep: 5,
+			},
+			wantErr: ErrInvalidLatitude,
+		},
+		{
+			name: "invalid longitude",
+			args: args{
+				location: Location{Latitude: 59.9, Longitude: 181},
+				timestep: 5,
+			},
+			wantErr: ErrInvalidLongitude,
+		},
+		{
+			name: "timestep too small",
+			args: args{
+				location: Location{Latitude: 59.9, Longitude: 180},
+				timestep: 0,
+			},
+			wantErr: ErrInvalidTimestep,
+		},
+		{
+			name: "timestep too large",
+			args: args{
+				location: Location{Latitude: 59.9, Longitude: 180},
+				timestep: 61,
+			},
+			wantErr: ErrInvalidTimestep,
+		},
+		{
+			name: "end time before start time",
+			args: args{
+				location:  Location{Latitude: 59.9, Longitude: 180},
+				timestep:  5,
+				startTime: startTime,
+				endTime:   startTime.Add(-time.Hour),
+			},
+			wantErr: ErrInvalidTimeRange,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&Client{}).validateNowcastArgs(tt.args.location, tt.args.timestep, tt.args.startTime, tt.args.endTime, tt.args.fields...)
+
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_validateNowcastArgs_fieldError(t *testing.T) {
+	err := (&Client{}).validateNowcastArgs(Location{Latitude: 59.9, Longitude: 180}, 5, time.Time{}, time.Time{}, Temperature, MoonPhase, FireIndex)
+
+	if err == nil {
+		t.Error("(&Client{}).validateNowcastArgs() error, expected err to be non nil")
+		return
+	}
+
+	want := "bad request /v3/weather/nowcast: invalid fields provided (moon_phase, fire_index)"
+	if err.Error() != want {
+		t.Errorf("(&Client{}).validateNowcastArgs() error, got = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestClient_Nowcast(t *testing.T) {
+	mockData, err := ioutil.ReadFile("mocks/nowcast_response.json")
+
+	if err != nil {
+		t.Fatal("error setting up mock response file")
+		return
+	}
+
+	startTime := time.Date(2020, 12, 7, 21, 7, 0, 0, time.FixedZone("CET", 3600))
+	endTime := startTime.Add(5 * time.Minute)
+
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "/v3/weather/nowcast", r.URL.Path)
+		assert.Equal(t, "5", q.Get("timestep"))
+		assert.Equal(t, "2020-12-07T20:07:00Z", q.Get("start_time"))
+		assert.Equal(t, "2020-12-07T20:12:00Z", q.Get("end_time"))
+		assert.Equal(t, "temp,precipitation", q.Get("fields"))
+
+		w.WriteHeader(200)
+		w.Write(mockData)
+	})
+
+	defer closeFunc()
+
+	c, err := NewClient("apikey", srv.Client())
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	resp, err := c.Nowcast(mockLocation, Si, 5, startTime, endTime, Temperature, Precipitation)
+
+	if err != nil {
+		t.Errorf("Nowcast() error = %v, want nil", err.Error())
+		return
+	}
+
+	assert.Len(t, resp, 2)
+	assert.Equal(t, 3.63, *resp[0].Temperature.Value)
+	assert.Equal(t, 0.12, *resp[1].Precipitation.Value)
+	assert.Equal(t, endTime.UTC(), resp[1].ObservationTime.Value)
+}
+
+func TestClient_Nowcast_non200response(t *testing.T) {
+	mockData, err := json.Marshal(HTTPError{Message: "mock error message"})
+
+	if err != nil {
+		t.Fatal("error setting up mock body")
+	}
+
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(403)
+		w.Write(mockData)
+	})
+
+	defer closeFunc()
+
+	c, err := NewClient("apikey", srv.Client())
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	resp, err := c.Nowcast(mockLocation, Si, 5, time.Time{}, time.Time{}, Temperature)
+
+	if err == nil {
+		t.Error("Nowcast() error = nil, expected non nil")
+		return
+	}
+
+	wantMsg := "forbidden /v3/weather/nowcast: mock error message"
+
+	if err.Error() != wantMsg {
+		t.Errorf("Nowcast() error message = %q, want %q", err.Error(), wantMsg)
+	}
+
+	assert.Nil(t, resp)
+}
+
+func TestClient_NowcastWithContext_deadlineExceeded(t *testing.T) {
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+
+	defer closeFunc()
+
+	c, err := NewClient("apikey", srv.Client())
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	resp, err := c.NowcastWithContext(ctx, mockLocation, Si, 5, time.Time{}, time.Time{}, Temperature)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, resp)
+}
@@ -0,0 +1,140 @@
+package climacell
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Option configures a Client, it's passed to NewClientWithOptions
+type Option func(*Client) error
+
+// WithBaseURL sets the base url of the climacell API used by the Client, e.g. to
+// point the Client to a local stand-in of the API
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) error {
+		u, err := url.Parse(baseURL)
+
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return ErrInvalidBaseURL
+		}
+
+		c.baseURL = baseURL
+		return nil
+	}
+}
+
+// WithHTTPClient sets the http.Client used to call the API, a nil http.Client is ignored
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) error {
+		if httpClient != nil {
+			c.httpClient = httpClient
+		}
+
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) error {
+		c.userAgent = userAgent
+		return nil
+	}
+}
+
+// WithTimeout sets the timeout of the http.Client, when combined with WithHTTPClient
+// a copy of the provided http.Client is used so the original is left untouched
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) error {
+		if timeout <= 0 {
+			return fmt.Errorf("%w: timeout must be positive, got %v", ErrInvalidOption, timeout)
+		}
+
+		c.timeout = timeout
+		return nil
+	}
+}
+
+// WithRetryPolicy enables retrying requests that failed with a 429 - too many requests
+// or a 5xx response according to the provided RetryPolicy
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) error {
+		if policy.MaxAttempts < 1 || policy.BaseDelay < 0 || policy.MaxDelay < 0 {
+			return fmt.Errorf("%w: invalid retry policy %+v", ErrInvalidOption, policy)
+		}
+
+		c.retryPolicy = policy
+		return nil
+	}
+}
+
+// WithRateLimits enables the client side rate limiter, every request sent by the Client (including
+// retries) counts towards the provided limits
+func WithRateLimits(limits RateLimits) Option {
+	return func(c *Client) error {
+		if limits.PerSecond < 0 || limits.PerHour < 0 || limits.PerDay < 0 {
+			return fmt.Errorf("%w: invalid rate limits %+v", ErrInvalidOption, limits)
+		}
+
+		c.rateLimiter = newRateLimiter(limits)
+		return nil
+	}
+}
+
+// WithQuotaCallback sets a callback which receives the quota reported by the rate limit
+// headers of every API response, it's called from the goroutine that made the request
+func WithQuotaCallback(callback QuotaCallback) Option {
+	return func(c *Client) error {
+		c.quotaCallback = callback
+		return nil
+	}
+}
+
+// WithCache enables caching of successful responses in the provided Cache for the duration of the ttl,
+// use BypassCache to skip the cache for a single request
+func WithCache(cache Cache, ttl time.Duration) Option {
+	return func(c *Client) error {
+		if cache == nil || ttl <= 0 {
+			return fmt.Errorf("%w: cache requires a non nil Cache and a positive ttl", ErrInvalidOption)
+		}
+
+		c.cache = cache
+		c.cacheTTL = ttl
+		return nil
+	}
+}
+
+// WithRequestCoalescing makes concurrent identical requests (same endpoint, coordinates, unit, fields
+// and parameters) share a single round trip, every caller receives the same response or error. When
+// the caller that started the round trip cancels it, the remaining callers share a new round trip
+func WithRequestCoalescing() Option {
+	return func(c *Client) error {
+		c.flights = &flightGroup{}
+		return nil
+	}
+}
+
+// WithoutCoverageCheck disables the client side check whether a location is covered by the endpoint,
+// so requests outside the documented coverage are left for the API to decide. Locations with
+// invalid coordinates are still rejected
+func WithoutCoverageCheck() Option {
+	return func(c *Client) error {
+		c.skipCoverageCheck = true
+		return nil
+	}
+}
+
+// WithAPIKeyHeader sets the name of the request header in which the api key is sent,
+// it defaults to "apikey"
+func WithAPIKeyHeader(header string) Option {
+	return func(c *Client) error {
+		if header == "" {
+			return fmt.Errorf("%w: api key header can't be empty", ErrInvalidOption)
+		}
+
+		c.apiKeyHeader = header
+		return nil
+	}
+}
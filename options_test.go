@@ -0,0 +1,125 @@
+package climacell
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestNewClientWithOptions(t *testing.T) {
+	c, err := NewClientWithOptions("c0ffee")
+	assert.NoError(t, err)
+	assert.Equal(t, BaseURL, c.baseURL)
+	assert.Equal(t, "apikey", c.apiKeyHeader)
+	assert.Equal(t, defaultTimeout, c.httpClient.Timeout)
+}
+
+func TestNewClientWithOptions_invalidAPIKey(t *testing.T) {
+	c, err := NewClientWithOptions("", WithBaseURL("http://localhost"))
+	assert.ErrorIs(t, err, ErrInvalidAPIKey)
+	assert.Nil(t, c)
+}
+
+func TestWithBaseURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		baseURL string
+		wantErr bool
+	}{
+		{
+			name:    "valid base URL",
+			baseURL: "http://localhost:8080/v3",
+		},
+		{
+			name:    "empty base URL",
+			baseURL: "",
+			wantErr: true,
+		},
+		{
+			name:    "base URL without scheme",
+			baseURL: "localhost",
+			wantErr: true,
+		},
+		{
+			name:    "unparsable base URL",
+			baseURL: " http://localhost",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewClientWithOptions("c0ffee", WithBaseURL(tt.baseURL))
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidBaseURL)
+				assert.Nil(t, c)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.baseURL, c.baseURL)
+		})
+	}
+}
+
+func TestWithHTTPClient(t *testing.T) {
+	httpClient := &http.Client{}
+	c, err := NewClientWithOptions("c0ffee", WithHTTPClient(httpClient))
+	assert.NoError(t, err)
+	assert.Same(t, httpClient, c.httpClient)
+
+	c, err = NewClientWithOptions("c0ffee", WithHTTPClient(nil))
+	assert.NoError(t, err)
+	assert.NotNil(t, c.httpClient)
+}
+
+func TestWithTimeout(t *testing.T) {
+	c, err := NewClientWithOptions("c0ffee", WithTimeout(time.Second))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Second, c.httpClient.Timeout)
+
+	httpClient := &http.Client{Timeout: time.Minute}
+	c, err = NewClientWithOptions("c0ffee", WithHTTPClient(httpClient), WithTimeout(time.Second))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Second, c.httpClient.Timeout)
+	assert.Equal(t, time.Minute, httpClient.Timeout)
+
+	c, err = NewClientWithOptions("c0ffee", WithTimeout(0))
+	assert.ErrorIs(t, err, ErrInvalidOption)
+	assert.Nil(t, c)
+}
+
+func TestWithAPIKeyHeader(t *testing.T) {
+	c, err := NewClientWithOptions("c0ffee", WithAPIKeyHeader(""))
+	assert.ErrorIs(t, err, ErrInvalidOption)
+	assert.Nil(t, c)
+}
+
+func TestNewClientWithOptions_requestHeaders(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "c0ffee", r.Header.Get("X-Api-Key"))
+		assert.Empty(t, r.Header.Get("apikey"))
+		assert.Equal(t, "climacell-test/1.0", r.Header.Get("User-Agent"))
+
+		w.WriteHeader(200)
+		w.Write([]byte("{}"))
+	}))
+
+	defer srv.Close()
+
+	c, err := NewClientWithOptions("c0ffee",
+		WithBaseURL(srv.URL),
+		WithHTTPClient(srv.Client()),
+		WithUserAgent("climacell-test/1.0"),
+		WithAPIKeyHeader("X-Api-Key"),
+	)
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	_, err = c.Realtime(mockLocation, Si, Temperature)
+	assert.NoError(t, err)
+}
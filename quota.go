@@ -0,0 +1,68 @@
+package climacell
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// QuotaWindow is the limit and the number of remaining calls of a single quota window
+type QuotaWindow struct {
+	Limit     int
+	Remaining int
+}
+
+// QuotaInfo contains the rate limit headers returned by the API, windows which
+// weren't part of the response are nil
+type QuotaInfo struct {
+	Second *QuotaWindow
+	Minute *QuotaWindow
+	Hour   *QuotaWindow
+	Day    *QuotaWindow
+	Month  *QuotaWindow
+	// Reset is the time until the quota resets, it's zero when the API didn't report it
+	Reset time.Duration
+}
+
+// QuotaCallback is called with the QuotaInfo of every API response that contains rate limit headers
+type QuotaCallback func(endpoint Endpoint, quota QuotaInfo)
+
+// parseQuotaInfo parses the rate limit headers, it returns nil when none are present
+func parseQuotaInfo(header http.Header) *QuotaInfo {
+	var quota QuotaInfo
+	found := false
+
+	windows := []struct {
+		name   string
+		window **QuotaWindow
+	}{
+		{name: "Second", window: &quota.Second},
+		{name: "Minute", window: &quota.Minute},
+		{name: "Hour", window: &quota.Hour},
+		{name: "Day", window: &quota.Day},
+		{name: "Month", window: &quota.Month},
+	}
+
+	for _, w := range windows {
+		limit, limitErr := strconv.Atoi(header.Get("X-RateLimit-Limit-" + w.name))
+		remaining, remainingErr := strconv.Atoi(header.Get("X-RateLimit-Remaining-" + w.name))
+
+		if limitErr != nil || remainingErr != nil {
+			continue
+		}
+
+		*w.window = &QuotaWindow{Limit: limit, Remaining: remaining}
+		found = true
+	}
+
+	if reset, err := strconv.Atoi(header.Get("RateLimit-Reset")); err == nil && reset >= 0 {
+		quota.Reset = time.Duration(reset) * time.Second
+		found = true
+	}
+
+	if !found {
+		return nil
+	}
+
+	return &quota
+}
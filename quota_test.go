@@ -0,0 +1,87 @@
+package climacell
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func Test_parseQuotaInfo(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit-Second", "10")
+	header.Set("X-RateLimit-Remaining-Second", "9")
+	header.Set("X-RateLimit-Limit-Day", "1000")
+	header.Set("X-RateLimit-Remaining-Day", "12")
+	header.Set("X-RateLimit-Limit-Hour", "100")
+	header.Set("RateLimit-Reset", "42")
+
+	want := &QuotaInfo{
+		Second: &QuotaWindow{Limit: 10, Remaining: 9},
+		Day:    &QuotaWindow{Limit: 1000, Remaining: 12},
+		Reset:  42 * time.Second,
+	}
+
+	assert.Equal(t, want, parseQuotaInfo(header))
+}
+
+func Test_parseQuotaInfo_noHeaders(t *testing.T) {
+	assert.Nil(t, parseQuotaInfo(http.Header{}))
+}
+
+func TestClient_Realtime_quotaCallback(t *testing.T) {
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit-Hour", "100")
+		w.Header().Set("X-RateLimit-Remaining-Hour", "99")
+		w.WriteHeader(200)
+		w.Write([]byte("{}"))
+	})
+
+	defer closeFunc()
+
+	var gotEndpoint Endpoint
+	var gotQuota QuotaInfo
+
+	c, err := NewClientWithOptions("apikey",
+		WithHTTPClient(srv.Client()),
+		WithQuotaCallback(func(endpoint Endpoint, quota QuotaInfo) {
+			gotEndpoint = endpoint
+			gotQuota = quota
+		}),
+	)
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	_, err = c.Realtime(mockLocation, Si, Temperature)
+
+	assert.NoError(t, err)
+	assert.Equal(t, RealtimeEndpoint, gotEndpoint)
+	assert.Equal(t, QuotaInfo{Hour: &QuotaWindow{Limit: 100, Remaining: 99}}, gotQuota)
+}
+
+func TestClient_Realtime_tooManyRequestsQuota(t *testing.T) {
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit-Hour", "100")
+		w.Header().Set("X-RateLimit-Remaining-Hour", "0")
+		w.Header().Set("RateLimit-Reset", "1800")
+		w.WriteHeader(429)
+		w.Write([]byte(`{"message": "API rate limit exceeded"}`))
+	})
+
+	defer closeFunc()
+
+	c, err := NewClient("apikey", srv.Client())
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	_, err = c.Realtime(mockLocation, Si, Temperature)
+
+	var tooManyRequestsError *TooManyRequestsError
+	if assert.ErrorAs(t, err, &tooManyRequestsError) {
+		assert.Equal(t, &QuotaInfo{Hour: &QuotaWindow{Limit: 100, Remaining: 0}, Reset: 30 * time.Minute}, tooManyRequestsError.Quota)
+	}
+}
@@ -0,0 +1,176 @@
+package climacell
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// RateLimits configures the client side rate limiter of a Client, set them according to the
+// call limits of your ClimaCell plan. A limit of zero isn't enforced
+type RateLimits struct {
+	PerSecond int
+	PerHour   int
+	PerDay    int
+	// FailFast makes a request fail with a RateLimitError instead of waiting until the
+	// limiter allows it to be sent
+	FailFast bool
+}
+
+// RateLimitUsage contains the usage of a single rate limit window
+type RateLimitUsage struct {
+	Window time.Duration
+	Limit  int
+	Used   int
+}
+
+// RateLimitError is returned when a request would exceed one of the RateLimits and FailFast is set
+type RateLimitError struct {
+	Window     time.Duration
+	Limit      int
+	RetryAfter time.Duration
+}
+
+// Error returns the string representation of the RateLimitError
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limit of %v calls per %v exceeded, retry after %v", e.Limit, e.Window, e.RetryAfter)
+}
+
+// tokenBucket holds up to limit tokens and refills them evenly over the window
+type tokenBucket struct {
+	window time.Duration
+	limit  int
+	tokens float64
+	last   time.Time
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	elapsed := now.Sub(b.last)
+
+	if elapsed <= 0 {
+		return
+	}
+
+	b.tokens += elapsed.Seconds() * float64(b.limit) / b.window.Seconds()
+
+	if b.tokens > float64(b.limit) {
+		b.tokens = float64(b.limit)
+	}
+
+	b.last = now
+}
+
+// wait returns the time until a token is available
+func (b *tokenBucket) wait() time.Duration {
+	if b.tokens >= 1 {
+		return 0
+	}
+
+	missing := 1 - b.tokens
+	return time.Duration(missing * float64(b.window) / float64(b.limit))
+}
+
+// rateLimiter is a set of token buckets, a request is only allowed when all of them have a token
+type rateLimiter struct {
+	mu       sync.Mutex
+	buckets  []*tokenBucket
+	failFast bool
+}
+
+func newRateLimiter(limits RateLimits) *rateLimiter {
+	limiter := &rateLimiter{failFast: limits.FailFast}
+	now := timeNow()
+
+	windows := []struct {
+		window time.Duration
+		limit  int
+	}{
+		{window: time.Second, limit: limits.PerSecond},
+		{window: time.Hour, limit: limits.PerHour},
+		{window: 24 * time.Hour, limit: limits.PerDay},
+	}
+
+	for _, w := range windows {
+		if w.limit > 0 {
+			limiter.buckets = append(limiter.buckets, &tokenBucket{
+				window: w.window,
+				limit:  w.limit,
+				tokens: float64(w.limit),
+				last:   now,
+			})
+		}
+	}
+
+	return limiter
+}
+
+// wait takes a token from every bucket, blocking until they're available or the
+// context is done. When the limiter fails fast a RateLimitError is returned instead
+func (l *rateLimiter) wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+
+		now := timeNow()
+		var delay time.Duration
+		var blocking *tokenBucket
+
+		for _, b := range l.buckets {
+			b.refill(now)
+
+			if d := b.wait(); d > delay {
+				delay = d
+				blocking = b
+			}
+		}
+
+		if blocking == nil {
+			for _, b := range l.buckets {
+				b.tokens--
+			}
+
+			l.mu.Unlock()
+			return nil
+		}
+
+		l.mu.Unlock()
+
+		if l.failFast {
+			return &RateLimitError{Window: blocking.window, Limit: blocking.limit, RetryAfter: delay}
+		}
+
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// usage returns the usage of every configured window
+func (l *rateLimiter) usage() []RateLimitUsage {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := timeNow()
+	usage := make([]RateLimitUsage, 0, len(l.buckets))
+
+	for _, b := range l.buckets {
+		b.refill(now)
+		usage = append(usage, RateLimitUsage{
+			Window: b.window,
+			Limit:  b.limit,
+			Used:   b.limit - int(b.tokens),
+		})
+	}
+
+	return usage
+}
+
+// RateLimitUsage returns the current usage of the client side rate limits, ordered from the
+// smallest to the largest window. It returns nil when no rate limits are configured
+func (c *Client) RateLimitUsage() []RateLimitUsage {
+	if c.rateLimiter == nil {
+		return nil
+	}
+
+	return c.rateLimiter.usage()
+}
@@ -0,0 +1,131 @@
+package climacell
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRateLimitError_Error(t *testing.T) {
+	e := &RateLimitError{Window: time.Hour, Limit: 100, RetryAfter: 36 * time.Second}
+	want := "rate limit of 100 calls per 1h0m0s exceeded, retry after 36s"
+	if got := e.Error(); got != want {
+		t.Errorf("Error() = %v, want %v", got, want)
+	}
+}
+
+func Test_rateLimiter_failFast(t *testing.T) {
+	now := mockNow
+	backupTimeNow := timeNow
+	timeNow = func() time.Time {
+		return now
+	}
+
+	defer func() {
+		timeNow = backupTimeNow
+	}()
+
+	limiter := newRateLimiter(RateLimits{PerSecond: 10, PerHour: 2, FailFast: true})
+
+	assert.NoError(t, limiter.wait(context.Background()))
+	assert.NoError(t, limiter.wait(context.Background()))
+
+	err := limiter.wait(context.Background())
+
+	var rateLimitError *RateLimitError
+	assert.ErrorAs(t, err, &rateLimitError)
+	assert.Equal(t, time.Hour, rateLimitError.Window)
+	assert.Equal(t, 2, rateLimitError.Limit)
+	assert.Equal(t, 30*time.Minute, rateLimitError.RetryAfter)
+
+	assert.Equal(t, []RateLimitUsage{
+		{Window: time.Second, Limit: 10, Used: 2},
+		{Window: time.Hour, Limit: 2, Used: 2},
+	}, limiter.usage())
+
+	// Half of the hourly window refills one token
+	now = now.Add(30 * time.Minute)
+	assert.NoError(t, limiter.wait(context.Background()))
+}
+
+func Test_rateLimiter_wait(t *testing.T) {
+	limiter := newRateLimiter(RateLimits{PerSecond: 100})
+
+	for i := 0; i < 100; i++ {
+		assert.NoError(t, limiter.wait(context.Background()))
+	}
+
+	start := time.Now()
+	assert.NoError(t, limiter.wait(context.Background()))
+	assert.True(t, time.Since(start) >= 5*time.Millisecond)
+}
+
+func Test_rateLimiter_waitContextDone(t *testing.T) {
+	limiter := newRateLimiter(RateLimits{PerHour: 1})
+	assert.NoError(t, limiter.wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assert.ErrorIs(t, limiter.wait(ctx), context.DeadlineExceeded)
+}
+
+func TestClient_RateLimitUsage(t *testing.T) {
+	var requests int32
+
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(200)
+		w.Write([]byte("{}"))
+	})
+
+	defer closeFunc()
+
+	c, err := NewClientWithOptions("apikey", WithHTTPClient(srv.Client()), WithRateLimits(RateLimits{PerDay: 5, FailFast: true}))
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	var wg sync.WaitGroup
+	var rateLimited int32
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.Realtime(mockLocation, Si, Temperature)
+
+			var rateLimitError *RateLimitError
+			if err != nil && assert.ErrorAs(t, err, &rateLimitError) {
+				atomic.AddInt32(&rateLimited, 1)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, int32(5), atomic.LoadInt32(&requests))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&rateLimited))
+	assert.Equal(t, []RateLimitUsage{{Window: 24 * time.Hour, Limit: 5, Used: 5}}, c.RateLimitUsage())
+}
+
+func TestClient_RateLimitUsage_notConfigured(t *testing.T) {
+	c, err := NewClient("apikey", nil)
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	assert.Nil(t, c.RateLimitUsage())
+}
+
+func TestWithRateLimits_invalid(t *testing.T) {
+	c, err := NewClientWithOptions("apikey", WithRateLimits(RateLimits{PerSecond: -1}))
+	assert.ErrorIs(t, err, ErrInvalidOption)
+	assert.Nil(t, c)
+}
@@ -1,55 +1,16 @@
 package climacell
 
-import (
-	"encoding/json"
-	"fmt"
-	"net/http"
-)
-
-var realtimeEndpoint = "/v3/weather/realtime"
-var unavailableRealtimeFields = []field{
-	PrecipitationProbability,
-	PrecipitationAccumulation,
-	CloudSatellite,
-	WeatherGroups,
-}
+import "context"
 
 // Realtime calls the realtime climacell endpoint with the provided fields
-func (c *Client) Realtime(latitude, longitude float64, unit unit, fields ...field) (*RealtimeData, error) {
-	err := validateRealtimeArgs(latitude, longitude, fields...)
-
-	if err != nil {
-		return nil, err
-	}
-
-	u, err := getURL(c.baseURL, realtimeEndpoint)
-
-	if err != nil {
-		return nil, err
-	}
-
-	q := u.Query()
-	q.Set("lat", floatToString(latitude))
-	q.Set("lon", floatToString(longitude))
-	q.Set("unit_system", unit.String())
-	q.Set("fields", joinFields(fields, ","))
-	u.RawQuery = q.Encode()
-
-	req, err := http.NewRequest("GET", u.String(), nil)
-
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("apikey", c.apiKey)
-
-	resp, err := c.httpClient.Do(req)
-
-	if err != nil {
-		return nil, err
-	}
+func (c *Client) Realtime(location Location, unit unit, fields ...Field) (*RealtimeData, error) {
+	return c.RealtimeWithContext(context.Background(), location, unit, fields...)
+}
 
-	err = checkHTTPError(resp, realtimeEndpoint)
+// RealtimeWithContext calls the realtime climacell endpoint with the provided fields, the request
+// is bound to the provided context
+func (c *Client) RealtimeWithContext(ctx context.Context, location Location, unit unit, fields ...Field) (*RealtimeData, error) {
+	err := c.validateRealtimeArgs(location, fields...)
 
 	if err != nil {
 		return nil, err
@@ -57,38 +18,26 @@ func (c *Client) Realtime(latitude, longitude float64, unit unit, fields ...fiel
 
 	var realtimeData RealtimeData
 
-	defer resp.Body.Close()
+	err = c.get(ctx, apiRequest{
+		endpoint: RealtimeEndpoint,
+		location: location,
+		unit:     unit,
+		fields:   fields,
+	}, &realtimeData)
 
-	if err := json.NewDecoder(resp.Body).Decode(&realtimeData); err != nil {
+	if err != nil {
 		return nil, err
 	}
 
 	return &realtimeData, nil
 }
 
-func validateRealtimeArgs(latitude, longitude float64, fields ...field) error {
-	if !validLatitude(latitude) {
-		return ErrInvalidLatitude
-	}
-
-	if !validLongitude(longitude) {
-		return ErrInvalidLongitude
-	}
-
-	var invalidRealtimeFields []field
-
-	for _, providedField := range fields {
-		for _, unavailableField := range unavailableRealtimeFields {
-			if providedField == unavailableField {
-				invalidRealtimeFields = append(invalidRealtimeFields, providedField)
-			}
-		}
-	}
+func (c *Client) validateRealtimeArgs(location Location, fields ...Field) error {
+	err := c.validateCoordinates(RealtimeEndpoint, location)
 
-	if invalidRealtimeFields != nil {
-		msg := joinFields(invalidRealtimeFields, ", ")
-		return newBadRequestError(realtimeEndpoint, fmt.Sprintf("invalid fields provided (%v)", msg))
+	if err != nil {
+		return err
 	}
 
-	return nil
+	return validateFields(RealtimeEndpoint, fields...)
 }
@@ -1,19 +1,20 @@
 package climacell
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/stretchr/testify/assert"
 	"io/ioutil"
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func Test_validateRealtimeArgs(t *testing.T) {
 	type args struct {
-		latitude  float64
-		longitude float64
-		fields    []field
+		location Location
+		fields   []Field
 	}
 	tests := []struct {
 		name    string
@@ -23,9 +24,8 @@ func Test_validateRealtimeArgs(t *testing.T) {
 		{
 			name: "valid arguments",
 			args: args{
-				latitude:  59.9,
-				longitude: 180,
-				fields: []field{
+				location: Location{Latitude: 59.9, Longitude: 180},
+				fields: []Field{
 					Temperature,
 					FeelsLike,
 					Precipitation,
@@ -35,9 +35,8 @@ func Test_validateRealtimeArgs(t *testing.T) {
 		{
 			name: "invalid latitude",
 			args: args{
-				latitude:  59.91,
-				longitude: 180,
-				fields: []field{
+				location: Location{Latitude: 59.91, Longitude: 180},
+				fields: []Field{
 					Temperature,
 					FeelsLike,
 					Precipitation,
@@ -48,9 +47,8 @@ func Test_validateRealtimeArgs(t *testing.T) {
 		{
 			name: "invalid longitude",
 			args: args{
-				latitude:  59.9,
-				longitude: 181,
-				fields: []field{
+				location: Location{Latitude: 59.9, Longitude: 181},
+				fields: []Field{
 					Temperature,
 					FeelsLike,
 					Precipitation,
@@ -61,9 +59,8 @@ func Test_validateRealtimeArgs(t *testing.T) {
 		{
 			name: "invalid field",
 			args: args{
-				latitude:  59.9,
-				longitude: 180,
-				fields: []field{
+				location: Location{Latitude: 59.9, Longitude: 180},
+				fields: []Field{
 					Temperature,
 					FeelsLike,
 					Precipitation,
@@ -75,29 +72,38 @@ func Test_validateRealtimeArgs(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := validateRealtimeArgs(tt.args.latitude, tt.args.longitude, tt.args.fields...); (err != nil) != tt.wantErr {
-				t.Errorf("validateRealtimeArgs() error = %v, wantErr %v", err, tt.wantErr)
+			if err := (&Client{}).validateRealtimeArgs(tt.args.location, tt.args.fields...); (err != nil) != tt.wantErr {
+				t.Errorf("(&Client{}).validateRealtimeArgs() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
 }
 
 func Test_validateRealtimeArgs_singleFieldError(t *testing.T) {
-	err := validateRealtimeArgs(59.9, 180, WeatherGroups, Temperature)
+	err := (&Client{}).validateRealtimeArgs(Location{Latitude: 59.9, Longitude: 180}, WeatherGroups, Temperature)
 
 	if err == nil {
-		t.Error("validateRealtimeArgs() error, expected err to be non nil")
+		t.Error("(&Client{}).validateRealtimeArgs() error, expected err to be non nil")
 		return
 	}
 
 	want := "bad request /v3/weather/realtime: invalid fields provided (weather_groups)"
 	if err.Error() != want {
-		t.Errorf("validateRealtimeArgs() error, got = %q, want %q", err.Error(), want)
+		t.Errorf("(&Client{}).validateRealtimeArgs() error, got = %q, want %q", err.Error(), want)
+	}
+}
+
+func Test_validateRealtimeArgs_unknownField(t *testing.T) {
+	err := (&Client{}).validateRealtimeArgs(mockLocation, Temperature, Field(99))
+
+	want := "bad request /v3/weather/realtime: invalid fields provided (Field(99))"
+	if err == nil || err.Error() != want {
+		t.Errorf("(&Client{}).validateRealtimeArgs() error, got = %v, want %q", err, want)
 	}
 }
 
 func Test_validateRealtimeArgs_multipleFieldError(t *testing.T) {
-	err := validateRealtimeArgs(59.9, 180,
+	err := (&Client{}).validateRealtimeArgs(Location{Latitude: 59.9, Longitude: 180},
 		PrecipitationProbability,
 		PrecipitationAccumulation,
 		CloudSatellite,
@@ -105,13 +111,13 @@ func Test_validateRealtimeArgs_multipleFieldError(t *testing.T) {
 	)
 
 	if err == nil {
-		t.Error("validateRealtimeArgs() error, expected err to be non nil")
+		t.Error("(&Client{}).validateRealtimeArgs() error, expected err to be non nil")
 		return
 	}
 
 	want := "bad request /v3/weather/realtime: invalid fields provided (precipitation_probability, precipitation_accumulation, cloud_satellite, weather_groups)"
 	if err.Error() != want {
-		t.Errorf("validateRealtimeArgs() error, got = %q, want %q", err.Error(), want)
+		t.Errorf("(&Client{}).validateRealtimeArgs() error, got = %q, want %q", err.Error(), want)
 	}
 }
 
@@ -136,10 +142,9 @@ func TestClient_Realtime(t *testing.T) {
 		t.Fatal("error setting up client")
 	}
 
-	latitude := 52.321234567890
-	longitude := 4.95124567890
+	location := Location{Latitude: 52.321234567890, Longitude: 4.95124567890}
 
-	resp, err := c.Realtime(latitude, longitude, Si, Temperature)
+	resp, err := c.Realtime(location, Si, Temperature, TreePollen, TreeBirchPollen, HailBinary)
 
 	if err != nil {
 		t.Errorf("Realtime() error = %v, want nil", err.Error())
@@ -147,6 +152,20 @@ func TestClient_Realtime(t *testing.T) {
 	}
 
 	assert.Equal(t, 3.63, *resp.Temperature.Value)
+	assert.Equal(t, 52.321234567890, resp.Latitude)
+	assert.Equal(t, 4.95124567890, resp.Longitude)
+	assert.Equal(t, time.Date(2020, 12, 7, 20, 6, 54, 764000000, time.UTC), resp.Time())
+	assert.Equal(t, "false", resp.HailBinary.String())
+	assert.Equal(t, 0, *resp.TreePollen.Value)
+	assert.Equal(t, "Climacell Pollen Index", resp.TreePollen.Units)
+	assert.Equal(t, 3, *resp.Birch.Value)
+	assert.Equal(t, "medium", resp.PollenTree.Birch.Level())
+	assert.Nil(t, resp.Oak)
+	assert.Equal(t, WeatherCodeMostlyCloudy, resp.WeatherCode.Value)
+	assert.Equal(t, MoonPhaseLastQuarter, resp.MoonPhase.Value)
+	assert.Equal(t, PrecipitationTypeUnknown, resp.PrecipitationType.Value)
+	assert.Equal(t, HealthConcernModerate, resp.HealthConcernEPA.Value)
+	assert.Equal(t, HealthConcernGood, resp.HealthConcernChinaMEP.Value)
 }
 
 func TestClient_Realtime_non200response(t *testing.T) {
@@ -173,10 +192,9 @@ func TestClient_Realtime_non200response(t *testing.T) {
 		t.Fatal("error setting up client")
 	}
 
-	latitude := 52.321234567890
-	longitude := 4.95124567890
+	location := Location{Latitude: 52.321234567890, Longitude: 4.95124567890}
 
-	resp, err := c.Realtime(latitude, longitude, Si, Temperature)
+	resp, err := c.Realtime(location, Si, Temperature)
 
 	if err == nil {
 		t.Error("Realtime() error = nil, expected non nil")
@@ -200,10 +218,9 @@ func TestClient_Realtime_invalidArgs(t *testing.T) {
 		apiKey     string
 	}
 	type args struct {
-		latitude  float64
-		longitude float64
-		unit      unit
-		fields    []field
+		location Location
+		unit     unit
+		fields   []Field
 	}
 	tests := []struct {
 		name    string
@@ -220,10 +237,9 @@ func TestClient_Realtime_invalidArgs(t *testing.T) {
 				apiKey:     "apikey",
 			},
 			args: args{
-				latitude:  -59.91,
-				longitude: 180,
-				unit:      Si,
-				fields:    nil,
+				location: Location{Latitude: -59.91, Longitude: 180},
+				unit:     Si,
+				fields:   nil,
 			},
 			want:    nil,
 			wantErr: true,
@@ -236,10 +252,9 @@ func TestClient_Realtime_invalidArgs(t *testing.T) {
 				apiKey:     "apikey",
 			},
 			args: args{
-				latitude:  -59.9,
-				longitude: 180.01,
-				unit:      Si,
-				fields:    nil,
+				location: Location{Latitude: -59.9, Longitude: 180.01},
+				unit:     Si,
+				fields:   nil,
 			},
 			want:    nil,
 			wantErr: true,
@@ -252,10 +267,9 @@ func TestClient_Realtime_invalidArgs(t *testing.T) {
 				apiKey:     "apikey",
 			},
 			args: args{
-				latitude:  -59.9,
-				longitude: 180,
-				unit:      Si,
-				fields:    nil,
+				location: Location{Latitude: -59.9, Longitude: 180},
+				unit:     Si,
+				fields:   nil,
 			},
 			want:    nil,
 			wantErr: true,
@@ -268,7 +282,7 @@ func TestClient_Realtime_invalidArgs(t *testing.T) {
 				baseURL:    tt.fields.baseURL,
 				apiKey:     tt.fields.apiKey,
 			}
-			got, err := c.Realtime(tt.args.latitude, tt.args.longitude, tt.args.unit, tt.args.fields...)
+			got, err := c.Realtime(tt.args.location, tt.args.unit, tt.args.fields...)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Realtime() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -280,3 +294,25 @@ func TestClient_Realtime_invalidArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_RealtimeWithContext_cancelled(t *testing.T) {
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Realtime() expected no request to be sent with a cancelled context")
+	})
+
+	defer closeFunc()
+
+	c, err := NewClient("apikey", srv.Client())
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	resp, err := c.RealtimeWithContext(ctx, mockLocation, Si, Temperature)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, resp)
+}
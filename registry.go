@@ -0,0 +1,217 @@
+package climacell
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Endpoint is the path of a climacell API endpoint
+type Endpoint string
+
+const (
+	RealtimeEndpoint            Endpoint = "/v3/weather/realtime"
+	NowcastEndpoint             Endpoint = "/v3/weather/nowcast"
+	HourlyEndpoint              Endpoint = "/v3/weather/forecast/hourly"
+	DailyEndpoint               Endpoint = "/v3/weather/forecast/daily"
+	HistoricalClimaCellEndpoint Endpoint = "/v3/weather/historical/climacell"
+	HistoricalStationEndpoint   Endpoint = "/v3/weather/historical/station"
+)
+
+// Layer is the data layer a field belongs to
+type Layer int
+
+const (
+	LayerCore Layer = iota
+	LayerAirQuality
+	LayerPollen
+	LayerRoad
+	LayerFire
+	LayerInsurance
+)
+
+var layerNames = []string{"core", "air quality", "pollen", "road", "fire", "insurance"}
+
+// String returns the string value of the layer
+func (l Layer) String() string {
+	if l < 0 || int(l) >= len(layerNames) {
+		return fmt.Sprintf("Layer(%d)", int(l))
+	}
+
+	return layerNames[l]
+}
+
+// FieldInfo contains the metadata of a field
+type FieldInfo struct {
+	Field Field
+	// Name is the name of the field as used by the API
+	Name  string
+	Layer Layer
+	// Type is the type of the decoded field in the realtime response, e.g. FloatData
+	Type reflect.Type
+	// SiUnits and UsUnits are the units the API reports the field in for each unit system,
+	// they're empty for fields without units
+	SiUnits     string
+	UsUnits     string
+	Endpoints   []Endpoint
+	Description string
+}
+
+// SupportedBy reports whether the field can be requested from the endpoint
+func (i FieldInfo) SupportedBy(endpoint Endpoint) bool {
+	for _, e := range i.Endpoints {
+		if e == endpoint {
+			return true
+		}
+	}
+
+	return false
+}
+
+// pollenUnits are the units of all pollen fields
+const pollenUnits = "Climacell Pollen Index"
+
+var (
+	floatType      = reflect.TypeOf(FloatData{})
+	intType        = reflect.TypeOf(IntData{})
+	stringDataType = reflect.TypeOf(StringData{})
+	timeType       = reflect.TypeOf(TimeData{})
+	boolType       = reflect.TypeOf(BoolData{})
+	pollenType     = reflect.TypeOf(PollenData{})
+	weatherType    = reflect.TypeOf(WeatherCodeData{})
+	precipType     = reflect.TypeOf(PrecipitationTypeData{})
+	moonType       = reflect.TypeOf(MoonPhaseData{})
+	concernType    = reflect.TypeOf(HealthConcernData{})
+	stringType     = reflect.TypeOf("")
+	stringsType    = reflect.TypeOf([]string{})
+	integerType    = reflect.TypeOf(0)
+)
+
+var (
+	allEndpoints = []Endpoint{
+		RealtimeEndpoint,
+		NowcastEndpoint,
+		HourlyEndpoint,
+		DailyEndpoint,
+		HistoricalClimaCellEndpoint,
+		HistoricalStationEndpoint,
+	}
+	// stationEndpoints support the observed core fields, everything but the daily forecast
+	stationEndpoints = []Endpoint{
+		RealtimeEndpoint,
+		NowcastEndpoint,
+		HourlyEndpoint,
+		HistoricalClimaCellEndpoint,
+		HistoricalStationEndpoint,
+	}
+	// modelEndpoints support the fields which are derived from the ClimaCell models
+	modelEndpoints = []Endpoint{
+		RealtimeEndpoint,
+		NowcastEndpoint,
+		HourlyEndpoint,
+		HistoricalClimaCellEndpoint,
+	}
+)
+
+// fieldRegistry contains the metadata of every field, in order of declaration
+var fieldRegistry = []FieldInfo{
+	// Core
+	{Field: Temperature, Name: "temp", Layer: LayerCore, Type: floatType, SiUnits: "C", UsUnits: "F", Endpoints: allEndpoints, Description: "Temperature"},
+	{Field: FeelsLike, Name: "feels_like", Layer: LayerCore, Type: floatType, SiUnits: "C", UsUnits: "F", Endpoints: allEndpoints, Description: "Wind chill and heat window based on season"},
+	{Field: DewPoint, Name: "dewpoint", Layer: LayerCore, Type: floatType, SiUnits: "C", UsUnits: "F", Endpoints: stationEndpoints, Description: "Temperature of the dew point"},
+	{Field: Humidity, Name: "humidity", Layer: LayerCore, Type: floatType, SiUnits: "%", UsUnits: "%", Endpoints: allEndpoints, Description: "Percent relative humidity"},
+	{Field: WindSpeed, Name: "wind_speed", Layer: LayerCore, Type: floatType, SiUnits: "m/s", UsUnits: "mph", Endpoints: allEndpoints, Description: "Wind speed"},
+	{Field: WindDirection, Name: "wind_direction", Layer: LayerCore, Type: floatType, SiUnits: "degrees", UsUnits: "degrees", Endpoints: allEndpoints, Description: "Wind direction in polar degrees, 0 is north"},
+	{Field: WindGust, Name: "wind_gust", Layer: LayerCore, Type: floatType, SiUnits: "m/s", UsUnits: "mph", Endpoints: stationEndpoints, Description: "Wind gust speed"},
+	{Field: BarometricPressure, Name: "baro_pressure", Layer: LayerCore, Type: floatType, SiUnits: "hPa", UsUnits: "inHg", Endpoints: allEndpoints, Description: "Barometric pressure, reduced to sea level"},
+	{Field: Precipitation, Name: "precipitation", Layer: LayerCore, Type: floatType, SiUnits: "mm/hr", UsUnits: "in/hr", Endpoints: allEndpoints, Description: "Precipitation intensity"},
+	{Field: PrecipitationType, Name: "precipitation_type", Layer: LayerCore, Type: precipType, Endpoints: stationEndpoints, Description: "Type of precipitation: none, rain, snow, ice pellets or freezing rain"},
+	{Field: PrecipitationProbability, Name: "precipitation_probability", Layer: LayerCore, Type: floatType, SiUnits: "%", UsUnits: "%", Endpoints: []Endpoint{HourlyEndpoint, DailyEndpoint}, Description: "Chance of precipitation"},
+	{Field: PrecipitationAccumulation, Name: "precipitation_accumulation", Layer: LayerCore, Type: floatType, SiUnits: "mm", UsUnits: "in", Endpoints: []Endpoint{DailyEndpoint}, Description: "Total precipitation accumulated over the day"},
+	{Field: Sunrise, Name: "sunrise", Layer: LayerCore, Type: timeType, Endpoints: allEndpoints, Description: "Time of sunrise"},
+	{Field: Sunset, Name: "sunset", Layer: LayerCore, Type: timeType, Endpoints: allEndpoints, Description: "Time of sunset"},
+	{Field: Visibility, Name: "visibility", Layer: LayerCore, Type: intType, SiUnits: "km", UsUnits: "mi", Endpoints: allEndpoints, Description: "Visibility distance"},
+	{Field: CloudCover, Name: "cloud_cover", Layer: LayerCore, Type: floatType, SiUnits: "%", UsUnits: "%", Endpoints: stationEndpoints, Description: "Fraction of the sky obscured by clouds"},
+	{Field: CloudBase, Name: "cloud_base", Layer: LayerCore, Type: intType, SiUnits: "m", UsUnits: "ft", Endpoints: stationEndpoints, Description: "Height of the lowest cloud base"},
+	{Field: CloudCeiling, Name: "cloud_ceiling", Layer: LayerCore, Type: intType, SiUnits: "m", UsUnits: "ft", Endpoints: stationEndpoints, Description: "Height of the cloud ceiling"},
+	{Field: CloudSatellite, Name: "cloud_satellite", Layer: LayerCore, Type: floatType, SiUnits: "%", UsUnits: "%", Description: "Satellite cloud cover, only available as map tiles"},
+	{Field: SurfaceShortwaveRadiation, Name: "surface_shortwave_radiation", Layer: LayerCore, Type: intType, SiUnits: "w/sqm", UsUnits: "w/sqm", Endpoints: modelEndpoints, Description: "Solar radiation reaching the surface"},
+	{Field: MoonPhase, Name: "moon_phase", Layer: LayerCore, Type: moonType, Endpoints: []Endpoint{RealtimeEndpoint, HourlyEndpoint, DailyEndpoint, HistoricalClimaCellEndpoint}, Description: "Phase of the moon"},
+	{Field: WeatherCode, Name: "weather_code", Layer: LayerCore, Type: weatherType, Endpoints: allEndpoints, Description: "Text description of the prevailing weather condition"},
+	{Field: WeatherGroups, Name: "weather_groups", Layer: LayerCore, Type: stringsType, Description: "Groups of weather conditions, only available in weather alerts"},
+
+	// Air quality
+	{Field: ParticleMatter25, Name: "pm25", Layer: LayerAirQuality, Type: floatType, SiUnits: "µg/m3", UsUnits: "µg/m3", Endpoints: modelEndpoints, Description: "Particulate matter smaller than 2.5 micrometers"},
+	{Field: ParticleMatter10, Name: "pm10", Layer: LayerAirQuality, Type: floatType, SiUnits: "µg/m3", UsUnits: "µg/m3", Endpoints: modelEndpoints, Description: "Particulate matter smaller than 10 micrometers"},
+	{Field: Ozone, Name: "o3", Layer: LayerAirQuality, Type: floatType, SiUnits: "ppb", UsUnits: "ppb", Endpoints: modelEndpoints, Description: "Ozone"},
+	{Field: NitrogenDioxide, Name: "no2", Layer: LayerAirQuality, Type: floatType, SiUnits: "ppb", UsUnits: "ppb", Endpoints: modelEndpoints, Description: "Nitrogen dioxide"},
+	{Field: CarbonMonoxide, Name: "co", Layer: LayerAirQuality, Type: floatType, SiUnits: "ppm", UsUnits: "ppm", Endpoints: modelEndpoints, Description: "Carbon monoxide"},
+	{Field: SulfurDioxide, Name: "so2", Layer: LayerAirQuality, Type: floatType, SiUnits: "ppb", UsUnits: "ppb", Endpoints: modelEndpoints, Description: "Sulfur dioxide"},
+	{Field: AirQualityIndexEPA, Name: "epa_aqi", Layer: LayerAirQuality, Type: floatType, Endpoints: modelEndpoints, Description: "Air quality index according to the US EPA standard"},
+	{Field: PrimaryPollutantEPA, Name: "epa_primary_pollutant", Layer: LayerAirQuality, Type: stringDataType, Endpoints: modelEndpoints, Description: "Primary pollutant according to the US EPA standard"},
+	{Field: HealthConcernEPA, Name: "epa_health_concern", Layer: LayerAirQuality, Type: concernType, Endpoints: modelEndpoints, Description: "Health concern according to the US EPA standard"},
+	{Field: AirQualityIndexChinaMEP, Name: "china_aqi", Layer: LayerAirQuality, Type: floatType, Endpoints: modelEndpoints, Description: "Air quality index according to the China MEP standard"},
+	{Field: PrimaryPollutantChinaMEP, Name: "china_primary_pollutant", Layer: LayerAirQuality, Type: stringDataType, Endpoints: modelEndpoints, Description: "Primary pollutant according to the China MEP standard"},
+	{Field: HealthConcertChinaMEP, Name: "china_health_concern", Layer: LayerAirQuality, Type: concernType, Endpoints: modelEndpoints, Description: "Health concern according to the China MEP standard"},
+
+	// Pollen
+	{Field: TreePollen, Name: "pollen_tree", Layer: LayerPollen, Type: pollenType, SiUnits: pollenUnits, UsUnits: pollenUnits, Endpoints: modelEndpoints, Description: "Tree pollen index, 0 (none) to 5 (very high)"},
+	{Field: WeedPollen, Name: "pollen_weed", Layer: LayerPollen, Type: pollenType, SiUnits: pollenUnits, UsUnits: pollenUnits, Endpoints: modelEndpoints, Description: "Weed pollen index, 0 (none) to 5 (very high)"},
+	{Field: GrassPollen, Name: "pollen_grass", Layer: LayerPollen, Type: pollenType, SiUnits: pollenUnits, UsUnits: pollenUnits, Endpoints: modelEndpoints, Description: "Grass pollen index, 0 (none) to 5 (very high)"},
+	{Field: TreeAcaciaPollen, Name: "pollen_tree_acacia", Layer: LayerPollen, Type: pollenType, SiUnits: pollenUnits, UsUnits: pollenUnits, Endpoints: modelEndpoints, Description: "Acacia tree pollen index, 0 (none) to 5 (very high)"},
+	{Field: TreeAshPollen, Name: "pollen_tree_ash", Layer: LayerPollen, Type: pollenType, SiUnits: pollenUnits, UsUnits: pollenUnits, Endpoints: modelEndpoints, Description: "Ash tree pollen index, 0 (none) to 5 (very high)"},
+	{Field: TreeBeechPollen, Name: "pollen_tree_beech", Layer: LayerPollen, Type: pollenType, SiUnits: pollenUnits, UsUnits: pollenUnits, Endpoints: modelEndpoints, Description: "Beech tree pollen index, 0 (none) to 5 (very high)"},
+	{Field: TreeBirchPollen, Name: "pollen_tree_birch", Layer: LayerPollen, Type: pollenType, SiUnits: pollenUnits, UsUnits: pollenUnits, Endpoints: modelEndpoints, Description: "Birch tree pollen index, 0 (none) to 5 (very high)"},
+	{Field: TreeCedarPollen, Name: "pollen_tree_cedar", Layer: LayerPollen, Type: pollenType, SiUnits: pollenUnits, UsUnits: pollenUnits, Endpoints: modelEndpoints, Description: "Cedar tree pollen index, 0 (none) to 5 (very high)"},
+	{Field: TreeCypressPollen, Name: "pollen_tree_cypress", Layer: LayerPollen, Type: pollenType, SiUnits: pollenUnits, UsUnits: pollenUnits, Endpoints: modelEndpoints, Description: "Cypress tree pollen index, 0 (none) to 5 (very high)"},
+	{Field: TreeElderPollen, Name: "pollen_tree_elder", Layer: LayerPollen, Type: pollenType, SiUnits: pollenUnits, UsUnits: pollenUnits, Endpoints: modelEndpoints, Description: "Elder tree pollen index, 0 (none) to 5 (very high)"},
+	{Field: TreeElmPollen, Name: "pollen_tree_elm", Layer: LayerPollen, Type: pollenType, SiUnits: pollenUnits, UsUnits: pollenUnits, Endpoints: modelEndpoints, Description: "Elm tree pollen index, 0 (none) to 5 (very high)"},
+	{Field: TreeHemlockPollen, Name: "pollen_tree_hemlock", Layer: LayerPollen, Type: pollenType, SiUnits: pollenUnits, UsUnits: pollenUnits, Endpoints: modelEndpoints, Description: "Hemlock tree pollen index, 0 (none) to 5 (very high)"},
+	{Field: TreeHickoryPollen, Name: "pollen_tree_hickory", Layer: LayerPollen, Type: pollenType, SiUnits: pollenUnits, UsUnits: pollenUnits, Endpoints: modelEndpoints, Description: "Hickory tree pollen index, 0 (none) to 5 (very high)"},
+	{Field: TreeJuniperPollen, Name: "pollen_tree_juniper", Layer: LayerPollen, Type: pollenType, SiUnits: pollenUnits, UsUnits: pollenUnits, Endpoints: modelEndpoints, Description: "Juniper tree pollen index, 0 (none) to 5 (very high)"},
+	{Field: TreeMahoganyPollen, Name: "pollen_tree_mahogany", Layer: LayerPollen, Type: pollenType, SiUnits: pollenUnits, UsUnits: pollenUnits, Endpoints: modelEndpoints, Description: "Mahogany tree pollen index, 0 (none) to 5 (very high)"},
+	{Field: TreeMaplePollen, Name: "pollen_tree_maple", Layer: LayerPollen, Type: pollenType, SiUnits: pollenUnits, UsUnits: pollenUnits, Endpoints: modelEndpoints, Description: "Maple tree pollen index, 0 (none) to 5 (very high)"},
+	{Field: TreeMulberryPollen, Name: "pollen_tree_mulberry", Layer: LayerPollen, Type: pollenType, SiUnits: pollenUnits, UsUnits: pollenUnits, Endpoints: modelEndpoints, Description: "Mulberry tree pollen index, 0 (none) to 5 (very high)"},
+	{Field: TreeOakPollen, Name: "pollen_tree_oak", Layer: LayerPollen, Type: pollenType, SiUnits: pollenUnits, UsUnits: pollenUnits, Endpoints: modelEndpoints, Description: "Oak tree pollen index, 0 (none) to 5 (very high)"},
+	{Field: TreePinePollen, Name: "pollen_tree_pine", Layer: LayerPollen, Type: pollenType, SiUnits: pollenUnits, UsUnits: pollenUnits, Endpoints: modelEndpoints, Description: "Pine tree pollen index, 0 (none) to 5 (very high)"},
+	{Field: TreeCottonwoodPollen, Name: "pollen_tree_cottonwood", Layer: LayerPollen, Type: pollenType, SiUnits: pollenUnits, UsUnits: pollenUnits, Endpoints: modelEndpoints, Description: "Cottonwood tree pollen index, 0 (none) to 5 (very high)"},
+	{Field: TreeSprucePollen, Name: "pollen_tree_spruce", Layer: LayerPollen, Type: pollenType, SiUnits: pollenUnits, UsUnits: pollenUnits, Endpoints: modelEndpoints, Description: "Spruce tree pollen index, 0 (none) to 5 (very high)"},
+	{Field: TreeSycamorePollen, Name: "pollen_tree_sycamore", Layer: LayerPollen, Type: pollenType, SiUnits: pollenUnits, UsUnits: pollenUnits, Endpoints: modelEndpoints, Description: "Sycamore tree pollen index, 0 (none) to 5 (very high)"},
+	{Field: TreeWalnutPollen, Name: "pollen_tree_walnut", Layer: LayerPollen, Type: pollenType, SiUnits: pollenUnits, UsUnits: pollenUnits, Endpoints: modelEndpoints, Description: "Walnut tree pollen index, 0 (none) to 5 (very high)"},
+	{Field: TreeWillowPollen, Name: "pollen_tree_willow", Layer: LayerPollen, Type: pollenType, SiUnits: pollenUnits, UsUnits: pollenUnits, Endpoints: modelEndpoints, Description: "Willow tree pollen index, 0 (none) to 5 (very high)"},
+	{Field: WeedRagweedPollen, Name: "pollen_weed_ragweed", Layer: LayerPollen, Type: pollenType, SiUnits: pollenUnits, UsUnits: pollenUnits, Endpoints: modelEndpoints, Description: "Ragweed pollen index, 0 (none) to 5 (very high)"},
+	{Field: GrassGrassPollen, Name: "pollen_grass_grass", Layer: LayerPollen, Type: pollenType, SiUnits: pollenUnits, UsUnits: pollenUnits, Endpoints: modelEndpoints, Description: "Grass species pollen index, 0 (none) to 5 (very high)"},
+
+	// Road
+	{Field: RoadRiskScore, Name: "road_risk_score", Layer: LayerRoad, Type: stringType, Endpoints: modelEndpoints, Description: "Road risk score, 0 (no risk) to 5 (extreme risk)"},
+	{Field: RoadRisk, Name: "road_risk", Layer: LayerRoad, Type: stringType, Endpoints: modelEndpoints, Description: "Road risk: low, moderate or high"},
+	{Field: RoadRiskConfidence, Name: "road_risk_confidence", Layer: LayerRoad, Type: integerType, Endpoints: modelEndpoints, Description: "Confidence of the road risk, 0 to 100"},
+	{Field: RoadRiskConditions, Name: "road_risk_conditions", Layer: LayerRoad, Type: stringType, Endpoints: modelEndpoints, Description: "Conditions causing the road risk"},
+
+	// Fire
+	{Field: FireIndex, Name: "fire_index", Layer: LayerFire, Type: floatType, Endpoints: []Endpoint{RealtimeEndpoint, HistoricalClimaCellEndpoint}, Description: "Fire weather index, 0 (low risk) to 100 (extreme risk)"},
+
+	// Insurance
+	{Field: HailBinary, Name: "hail_binary", Layer: LayerInsurance, Type: boolType, Endpoints: modelEndpoints, Description: "Whether hail is expected, 0 or 1"},
+}
+
+// Info returns the metadata of the field, the zero FieldInfo for an unknown field
+func (f Field) Info() FieldInfo {
+	if !f.known() {
+		return FieldInfo{}
+	}
+
+	return fieldRegistry[f]
+}
+
+// SupportedBy reports whether the field can be requested from the endpoint
+func (f Field) SupportedBy(endpoint Endpoint) bool {
+	return f.known() && fieldRegistry[f].SupportedBy(endpoint)
+}
+
+// Fields returns the metadata of every field, in order of declaration
+func Fields() []FieldInfo {
+	fields := make([]FieldInfo, len(fieldRegistry))
+	copy(fields, fieldRegistry)
+
+	return fields
+}
@@ -0,0 +1,148 @@
+package climacell
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_fieldRegistry(t *testing.T) {
+	for i, info := range fieldRegistry {
+		assert.Equal(t, Field(i), info.Field, "registry entry %v is out of order", info.Name)
+		assert.NotEmpty(t, info.Name)
+		assert.NotNil(t, info.Type, "missing type for %v", info.Name)
+		assert.NotEmpty(t, info.Description, "missing description for %v", info.Name)
+	}
+
+	assert.Equal(t, int(HailBinary)+1, len(fieldRegistry))
+}
+
+func Test_field_SupportedBy(t *testing.T) {
+	tests := []struct {
+		name     string
+		f        Field
+		endpoint Endpoint
+		want     bool
+	}{
+		{
+			name:     "temperature realtime",
+			f:        Temperature,
+			endpoint: RealtimeEndpoint,
+			want:     true,
+		},
+		{
+			name:     "temperature historical station",
+			f:        Temperature,
+			endpoint: HistoricalStationEndpoint,
+			want:     true,
+		},
+		{
+			name:     "precipitation probability realtime",
+			f:        PrecipitationProbability,
+			endpoint: RealtimeEndpoint,
+			want:     false,
+		},
+		{
+			name:     "precipitation probability hourly",
+			f:        PrecipitationProbability,
+			endpoint: HourlyEndpoint,
+			want:     true,
+		},
+		{
+			name:     "precipitation accumulation daily",
+			f:        PrecipitationAccumulation,
+			endpoint: DailyEndpoint,
+			want:     true,
+		},
+		{
+			name:     "moon phase nowcast",
+			f:        MoonPhase,
+			endpoint: NowcastEndpoint,
+			want:     false,
+		},
+		{
+			name:     "dew point daily",
+			f:        DewPoint,
+			endpoint: DailyEndpoint,
+			want:     false,
+		},
+		{
+			name:     "fire index hourly",
+			f:        FireIndex,
+			endpoint: HourlyEndpoint,
+			want:     false,
+		},
+		{
+			name:     "fire index historical climacell",
+			f:        FireIndex,
+			endpoint: HistoricalClimaCellEndpoint,
+			want:     true,
+		},
+		{
+			name:     "pm25 historical station",
+			f:        ParticleMatter25,
+			endpoint: HistoricalStationEndpoint,
+			want:     false,
+		},
+		{
+			name:     "birch pollen realtime",
+			f:        TreeBirchPollen,
+			endpoint: RealtimeEndpoint,
+			want:     true,
+		},
+		{
+			name:     "ragweed pollen daily",
+			f:        WeedRagweedPollen,
+			endpoint: DailyEndpoint,
+			want:     false,
+		},
+		{
+			name:     "weather groups realtime",
+			f:        WeatherGroups,
+			endpoint: RealtimeEndpoint,
+			want:     false,
+		},
+		{
+			name:     "unknown field",
+			f:        Field(99),
+			endpoint: RealtimeEndpoint,
+			want:     false,
+		},
+		{
+			name:     "negative field",
+			f:        Field(-1),
+			endpoint: RealtimeEndpoint,
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.f.SupportedBy(tt.endpoint))
+		})
+	}
+}
+
+func Test_field_Info(t *testing.T) {
+	info := Temperature.Info()
+
+	assert.Equal(t, "temp", info.Name)
+	assert.Equal(t, LayerCore, info.Layer)
+	assert.Equal(t, "C", info.SiUnits)
+	assert.Equal(t, "F", info.UsUnits)
+	assert.Equal(t, LayerFire, FireIndex.Info().Layer)
+	assert.Equal(t, FieldInfo{}, Field(99).Info())
+}
+
+func TestFields(t *testing.T) {
+	fields := Fields()
+	fields[0].Name = "modified"
+
+	assert.Len(t, fields, len(fieldRegistry))
+	assert.Equal(t, "temp", Temperature.String())
+}
+
+func TestLayer_String(t *testing.T) {
+	assert.Equal(t, "core", LayerCore.String())
+	assert.Equal(t, "air quality", LayerAirQuality.String())
+	assert.Equal(t, "insurance", LayerInsurance.String())
+	assert.Equal(t, "Layer(6)", Layer(6).String())
+}
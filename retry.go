@@ -0,0 +1,119 @@
+package climacell
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how requests that failed with a 429 - too many requests or a 5xx
+// response are retried. The delay before a retry grows exponentially from BaseDelay and is
+// randomized with jitter, a Retry-After header returned by the API takes precedence
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first request
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry, it doubles for every next retry
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between two attempts, including a delay requested with Retry-After,
+	// zero means no cap
+	MaxDelay time.Duration
+}
+
+// RetryError is returned when a request failed and the RetryPolicy retried it at least once
+type RetryError struct {
+	Attempts int
+	Err      error
+}
+
+// Error returns the string representation of the RetryError
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("failed after %v attempts: %v", e.Attempts, e.Err)
+}
+
+// Unwrap returns the error of the last attempt
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// retryable reports whether a response with the provided status code should be retried
+func (p RetryPolicy) retryable(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode/100 == 5
+}
+
+// delay returns the time to wait before the next attempt, attempt is the number of the attempt that failed
+func (p RetryPolicy) delay(attempt int, header http.Header) time.Duration {
+	if retryAfter, ok := parseRetryAfter(header.Get("Retry-After")); ok {
+		if p.MaxDelay > 0 && retryAfter > p.MaxDelay {
+			return p.MaxDelay
+		}
+
+		return retryAfter
+	}
+
+	if p.BaseDelay <= 0 {
+		return 0
+	}
+
+	shift := uint(attempt - 1)
+	delay := time.Duration(math.MaxInt64)
+
+	// Only shift when the result fits, larger delays saturate instead of overflowing
+	if shift < 63 && p.BaseDelay <= time.Duration(math.MaxInt64>>shift) {
+		delay = p.BaseDelay << shift
+	}
+
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+
+	// Equal jitter, keeps at least half of the delay so retries don't become instant
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(delay-half)+1))
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either
+// a number of seconds or an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+
+	if err != nil {
+		return 0, false
+	}
+
+	delay := date.Sub(timeNow())
+
+	if delay < 0 {
+		delay = 0
+	}
+
+	return delay, true
+}
+
+// sleep waits for the provided duration or until the context is done
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
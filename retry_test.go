@@ -0,0 +1,274 @@
+package climacell
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryError_Error(t *testing.T) {
+	e := &RetryError{Attempts: 3, Err: newInternalServerError("/v3/weather/test", "test message")}
+	want := "failed after 3 attempts: internal server error /v3/weather/test: test message"
+	if got := e.Error(); got != want {
+		t.Errorf("Error() = %v, want %v", got, want)
+	}
+}
+
+func TestRetryPolicy_retryable(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3}
+	tests := []struct {
+		statusCode int
+		want       bool
+	}{
+		{statusCode: 200, want: false},
+		{statusCode: 400, want: false},
+		{statusCode: 404, want: false},
+		{statusCode: 429, want: true},
+		{statusCode: 500, want: true},
+		{statusCode: 503, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.statusCode), func(t *testing.T) {
+			if got := policy.retryable(tt.statusCode); got != tt.want {
+				t.Errorf("retryable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_delay(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 5, BaseDelay: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond}
+
+	for i := 0; i < 100; i++ {
+		delay := policy.delay(1, http.Header{})
+		assert.True(t, 50*time.Millisecond <= delay && delay <= 100*time.Millisecond, "attempt 1 delay %v", delay)
+
+		delay = policy.delay(2, http.Header{})
+		assert.True(t, 100*time.Millisecond <= delay && delay <= 200*time.Millisecond, "attempt 2 delay %v", delay)
+
+		delay = policy.delay(4, http.Header{})
+		assert.True(t, 150*time.Millisecond <= delay && delay <= 300*time.Millisecond, "attempt 4 delay %v", delay)
+	}
+
+	header := http.Header{}
+	header.Set("Retry-After", "2")
+	assert.Equal(t, 300*time.Millisecond, policy.delay(1, header), "Retry-After is capped by MaxDelay")
+
+	uncapped := RetryPolicy{MaxAttempts: 5, BaseDelay: 100 * time.Millisecond}
+	assert.Equal(t, 2*time.Second, uncapped.delay(1, header))
+}
+
+func TestRetryPolicy_delayOverflow(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 100, BaseDelay: time.Second}
+
+	for _, attempt := range []int{35, 40, 64, 100} {
+		delay := policy.delay(attempt, http.Header{})
+		assert.True(t, delay >= time.Duration(math.MaxInt64)/2, "attempt %v delay %v", attempt, delay)
+	}
+
+	policy.MaxDelay = time.Minute
+
+	for _, attempt := range []int{35, 64, 100} {
+		delay := policy.delay(attempt, http.Header{})
+		assert.True(t, 30*time.Second <= delay && delay <= time.Minute, "attempt %v delay %v", attempt, delay)
+	}
+}
+
+func Test_parseRetryAfter(t *testing.T) {
+	defer setupMockNow()()
+
+	tests := []struct {
+		name   string
+		value  string
+		want   time.Duration
+		wantOk bool
+	}{
+		{
+			name:   "seconds",
+			value:  "120",
+			want:   2 * time.Minute,
+			wantOk: true,
+		},
+		{
+			name:   "http date",
+			value:  mockNow.Add(30 * time.Second).Format(http.TimeFormat),
+			want:   30 * time.Second,
+			wantOk: true,
+		},
+		{
+			name:   "http date in the past",
+			value:  mockNow.Add(-30 * time.Second).Format(http.TimeFormat),
+			want:   0,
+			wantOk: true,
+		},
+		{
+			name:  "empty",
+			value: "",
+		},
+		{
+			name:  "negative seconds",
+			value: "-1",
+		},
+		{
+			name:  "invalid",
+			value: "soon",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseRetryAfter(tt.value)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_Realtime_retry(t *testing.T) {
+	var requests int32
+
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(429)
+			w.Write([]byte(`{"message": "rate limited"}`))
+			return
+		}
+
+		w.WriteHeader(200)
+		w.Write([]byte(`{"temp": {"value": 3.63, "units": "C"}}`))
+	})
+
+	defer closeFunc()
+
+	c, err := NewClientWithOptions("apikey", WithHTTPClient(srv.Client()), WithRetryPolicy(RetryPolicy{MaxAttempts: 3}))
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	resp, err := c.Realtime(mockLocation, Si, Temperature)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3.63, *resp.Temperature.Value)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+}
+
+func TestClient_Realtime_retryExhausted(t *testing.T) {
+	var requests int32
+	mockData, err := json.Marshal(HTTPError{Message: "mock error message"})
+
+	if err != nil {
+		t.Fatal("error setting up mock body")
+	}
+
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(500)
+		w.Write(mockData)
+	})
+
+	defer closeFunc()
+
+	c, err := NewClientWithOptions("apikey",
+		WithHTTPClient(srv.Client()),
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}),
+	)
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	resp, err := c.Realtime(mockLocation, Si, Temperature)
+
+	var retryError *RetryError
+	var internalServerError *InternalServerError
+	assert.ErrorAs(t, err, &retryError)
+	assert.Equal(t, 3, retryError.Attempts)
+	assert.ErrorAs(t, err, &internalServerError)
+	assert.Nil(t, resp)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+}
+
+func TestClient_Realtime_retryExhaustedHTML(t *testing.T) {
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(502)
+		w.Write([]byte("<html><body>502 Bad Gateway</body></html>"))
+	})
+
+	defer closeFunc()
+
+	c, err := NewClientWithOptions("apikey",
+		WithHTTPClient(srv.Client()),
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}),
+	)
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	_, err = c.Realtime(mockLocation, Si, Temperature)
+
+	var httpError *HTTPError
+	assert.ErrorAs(t, err, &httpError)
+	assert.EqualError(t, err, "failed after 3 attempts: error /v3/weather/realtime: 502 Bad Gateway")
+}
+
+func TestClient_Realtime_retryNotRetryable(t *testing.T) {
+	var requests int32
+
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(400)
+		w.Write([]byte(`{"message": "mock error message"}`))
+	})
+
+	defer closeFunc()
+
+	c, err := NewClientWithOptions("apikey", WithHTTPClient(srv.Client()), WithRetryPolicy(RetryPolicy{MaxAttempts: 3}))
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	_, err = c.Realtime(mockLocation, Si, Temperature)
+
+	var badRequestError *BadRequestError
+	assert.ErrorAs(t, err, &badRequestError)
+	assert.Equal(t, "bad request /v3/weather/realtime: mock error message", err.Error())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
+
+func TestClient_Realtime_retryContextCancelled(t *testing.T) {
+	srv, closeFunc := setupTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(429)
+		w.Write([]byte(`{"message": "rate limited"}`))
+	})
+
+	defer closeFunc()
+
+	c, err := NewClientWithOptions("apikey", WithHTTPClient(srv.Client()), WithRetryPolicy(RetryPolicy{MaxAttempts: 3}))
+
+	if err != nil {
+		t.Fatal("error setting up client")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = c.RealtimeWithContext(ctx, mockLocation, Si, Temperature)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWithRetryPolicy_invalid(t *testing.T) {
+	c, err := NewClientWithOptions("apikey", WithRetryPolicy(RetryPolicy{MaxAttempts: 0}))
+	assert.ErrorIs(t, err, ErrInvalidOption)
+	assert.Nil(t, c)
+}
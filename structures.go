@@ -6,14 +6,80 @@ import (
 	"time"
 )
 
-// RealtimeData embeds the CoreLayer, AirQualityLayer, PollenLayer, RoadLayer and FireLayer. It's the response type
-// for the Realtime() API call
+// RealtimeData embeds the ApiResponse, CoreLayer, AirQualityLayer, PollenLayer, RoadLayer, FireLayer and
+// InsuranceLayer. It's the response type for the Realtime() API call
 type RealtimeData struct {
+	ApiResponse
 	CoreLayer
 	AirQualityLayer
 	PollenLayer
 	RoadLayer
 	FireLayer
+	InsuranceLayer
+}
+
+// NowcastData embeds the ApiResponse, CoreLayer, AirQualityLayer, PollenLayer, RoadLayer and InsuranceLayer.
+// A slice of NowcastData, one for each timestep, is the response type for the Nowcast() API call
+type NowcastData struct {
+	ApiResponse
+	CoreLayer
+	AirQualityLayer
+	PollenLayer
+	RoadLayer
+	InsuranceLayer
+}
+
+// HourlyData embeds the ApiResponse, CoreLayer, AirQualityLayer, PollenLayer, RoadLayer and InsuranceLayer.
+// A slice of HourlyData, one for each hour, is the response type for the HourlyForecast() API call
+type HourlyData struct {
+	ApiResponse
+	CoreLayer
+	AirQualityLayer
+	PollenLayer
+	RoadLayer
+	InsuranceLayer
+}
+
+// HistoricalData embeds the ApiResponse, CoreLayer, AirQualityLayer, PollenLayer, RoadLayer, FireLayer and
+// InsuranceLayer. A slice of HistoricalData, one for each timestep, is the response type for the
+// HistoricalClimaCell() and HistoricalStation() API calls
+type HistoricalData struct {
+	ApiResponse
+	CoreLayer
+	AirQualityLayer
+	PollenLayer
+	RoadLayer
+	FireLayer
+	InsuranceLayer
+}
+
+// DailyData embeds the ApiResponse and contains the fields available in the daily forecast. Most fields
+// are reported as a minimum and maximum value per day. A slice of DailyData, one for each day, is the
+// response type for the DailyForecast() API call
+type DailyData struct {
+	ApiResponse
+	Temperature               *MinMaxData      `json:"temp,omitempty"`
+	FeelsLike                 *MinMaxData      `json:"feels_like,omitempty"`
+	Humidity                  *MinMaxData      `json:"humidity,omitempty"`
+	WindSpeed                 *MinMaxData      `json:"wind_speed,omitempty"`
+	WindDirection             *MinMaxData      `json:"wind_direction,omitempty"`
+	BarometricPressure        *MinMaxData      `json:"baro_pressure,omitempty"`
+	Visibility                *MinMaxData      `json:"visibility,omitempty"`
+	Precipitation             *MinMaxData      `json:"precipitation,omitempty"`
+	PrecipitationProbability  *FloatData       `json:"precipitation_probability,omitempty"`
+	PrecipitationAccumulation *FloatData       `json:"precipitation_accumulation,omitempty"`
+	Sunrise                   *TimeData        `json:"sunrise,omitempty"`
+	Sunset                    *TimeData        `json:"sunset,omitempty"`
+	MoonPhase                 *MoonPhaseData   `json:"moon_phase,omitempty"`
+	WeatherCode               *WeatherCodeData `json:"weather_code,omitempty"`
+}
+
+// TimeIn returns the start of the forecast day in the provided time zone. The API reports the day as
+// a date without a time zone, so the date is kept as is instead of being converted from UTC
+func (d DailyData) TimeIn(loc *time.Location) time.Time {
+	t := d.ObservationTime.Value
+
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
 }
 
 // ApiResponse is the basic api response which contains only latitude, longitude and observation time
@@ -23,57 +89,76 @@ type ApiResponse struct {
 	ObservationTime TimeData `json:"observation_time"`
 }
 
+// Location returns the coordinates of the response, the API may snap them to its grid
+func (r ApiResponse) Location() Location {
+	return Location{Latitude: r.Latitude, Longitude: r.Longitude}
+}
+
+// Time returns the observation time of the response in UTC
+func (r ApiResponse) Time() time.Time {
+	return r.ObservationTime.Value.UTC()
+}
+
+// TimeIn returns the observation time of the response in the provided time zone, e.g. the time zone
+// of the location
+func (r ApiResponse) TimeIn(loc *time.Location) time.Time {
+	return r.ObservationTime.Value.In(loc)
+}
+
 // CoreLayer is the data layer of type Core, which is used in
 // all ClimaCell products (not applicable to each data field in the layer)
 type CoreLayer struct {
-	Temperature               *FloatData  `json:"temp,omitempty"`
-	FeelsLike                 *FloatData  `json:"feels_like,omitempty"`
-	DewPoint                  *FloatData  `json:"dew_point,omitempty"`
-	WindSpeed                 *FloatData  `json:"wind_speed,omitempty"`
-	WindGust                  *FloatData  `json:"wind_gust,omitempty"`
-	BarometricPressure        *FloatData  `json:"baro_pressure,omitempty"`
-	Visibility                *IntData    `json:"visibility,omitempty"`
-	Humidity                  *FloatData  `json:"humidity,omitempty"`
-	WindDirection             *FloatData  `json:"wind_direction,omitempty"`
-	Precipitation             *FloatData  `json:"precipitation,omitempty"`
-	PrecipitationType         *StringData `json:"precipitation_type,omitempty"`
-	PrecipitationProbability  *FloatData  `json:"precipitation_probability,omitempty"`
-	PrecipitationAccumulation *FloatData  `json:"precipitation_accumulation,omitempty"`
-	CloudCover                *FloatData  `json:"cloud_cover,omitempty"`
-	CloudCeiling              *IntData    `json:"cloud_ceiling,omitempty"`
-	CloudBase                 *IntData    `json:"cloud_base,omitempty"`
-	CloudSatellite            *FloatData  `json:"cloud_satellite,omitempty"`
-	SurfaceShortwaveRadiation *IntData    `json:"surface_shortwave_radiation,omitempty"`
-	Sunrise                   *TimeData   `json:"sunrise,omitempty"`
-	Sunset                    *TimeData   `json:"sunset,omitempty"`
-	MoonPhase                 *StringData `json:"moon_phase,omitempty"`
-	WeatherCode               *StringData `json:"weather_code,omitempty"`
-	WeatherGroups             *[]string   `json:"weather_groups,omitempty"`
+	Temperature               *FloatData             `json:"temp,omitempty"`
+	FeelsLike                 *FloatData             `json:"feels_like,omitempty"`
+	DewPoint                  *FloatData             `json:"dewpoint,omitempty"`
+	WindSpeed                 *FloatData             `json:"wind_speed,omitempty"`
+	WindGust                  *FloatData             `json:"wind_gust,omitempty"`
+	BarometricPressure        *FloatData             `json:"baro_pressure,omitempty"`
+	Visibility                *IntData               `json:"visibility,omitempty"`
+	Humidity                  *FloatData             `json:"humidity,omitempty"`
+	WindDirection             *FloatData             `json:"wind_direction,omitempty"`
+	Precipitation             *FloatData             `json:"precipitation,omitempty"`
+	PrecipitationType         *PrecipitationTypeData `json:"precipitation_type,omitempty"`
+	PrecipitationProbability  *FloatData             `json:"precipitation_probability,omitempty"`
+	PrecipitationAccumulation *FloatData             `json:"precipitation_accumulation,omitempty"`
+	CloudCover                *FloatData             `json:"cloud_cover,omitempty"`
+	CloudCeiling              *IntData               `json:"cloud_ceiling,omitempty"`
+	CloudBase                 *IntData               `json:"cloud_base,omitempty"`
+	CloudSatellite            *FloatData             `json:"cloud_satellite,omitempty"`
+	SurfaceShortwaveRadiation *IntData               `json:"surface_shortwave_radiation,omitempty"`
+	Sunrise                   *TimeData              `json:"sunrise,omitempty"`
+	Sunset                    *TimeData              `json:"sunset,omitempty"`
+	MoonPhase                 *MoonPhaseData         `json:"moon_phase,omitempty"`
+	WeatherCode               *WeatherCodeData       `json:"weather_code,omitempty"`
+	WeatherGroups             *[]string              `json:"weather_groups,omitempty"`
 }
 
 // PollenLayer is the data layer of type Air quality, which is used in
 // Realtime, Nowcast, Hourly, ClimaCell and Tiles
 type AirQualityLayer struct {
-	ParticulateMatter25      *FloatData  `json:"pm25,omitempty"`
-	ParticulateMatter10      *FloatData  `json:"pm10,omitempty"`
-	Ozone                    *FloatData  `json:"03,omitempty"`
-	NitrogenDioxide          *FloatData  `json:"no2,omitempty"`
-	CarbonMonoxide           *FloatData  `json:"co,omitempty"`
-	SulfurDioxide            *FloatData  `json:"so2,omitempty"`
-	AirQualityIndexEPA       *FloatData  `json:"epa_aqi,omitempty"`
-	PrimaryPollutantEPA      *StringData `json:"epa_primary_pollutant,omitempty"`
-	HealthConcernEPA         *StringData `json:"epa_health_concern,omitempty"`
-	AirQualityIndexChinaMEP  *FloatData  `json:"china_aqi,omitempty"`
-	PrimaryPollutantChinaMEP *StringData `json:"china_primary_pollutant,omitempty"`
-	HealthConcernChinaMEP    *StringData `json:"china_health_concern,omitempty"`
+	ParticulateMatter25      *FloatData         `json:"pm25,omitempty"`
+	ParticulateMatter10      *FloatData         `json:"pm10,omitempty"`
+	Ozone                    *FloatData         `json:"o3,omitempty"`
+	NitrogenDioxide          *FloatData         `json:"no2,omitempty"`
+	CarbonMonoxide           *FloatData         `json:"co,omitempty"`
+	SulfurDioxide            *FloatData         `json:"so2,omitempty"`
+	AirQualityIndexEPA       *FloatData         `json:"epa_aqi,omitempty"`
+	PrimaryPollutantEPA      *StringData        `json:"epa_primary_pollutant,omitempty"`
+	HealthConcernEPA         *HealthConcernData `json:"epa_health_concern,omitempty"`
+	AirQualityIndexChinaMEP  *FloatData         `json:"china_aqi,omitempty"`
+	PrimaryPollutantChinaMEP *StringData        `json:"china_primary_pollutant,omitempty"`
+	HealthConcernChinaMEP    *HealthConcernData `json:"china_health_concern,omitempty"`
 }
 
 // PollenLayer is the data layer of type Pollen, which is used in
 // Realtime, Nowcast, Hourly, ClimaCell and Tiles
 type PollenLayer struct {
-	PollenTree  PollenTree  `json:"pollen_tree,omitempty"`
-	PollenWeed  PollenWeed  `json:"pollen_weed,omitempty"`
-	PollenGrass PollenGrass `json:"pollen_grass,omitempty"`
+	TreePollen  *PollenData `json:"pollen_tree,omitempty"`
+	WeedPollen  *PollenData `json:"pollen_weed,omitempty"`
+	GrassPollen *PollenData `json:"pollen_grass,omitempty"`
+	PollenTree
+	PollenWeed
+	PollenGrass
 }
 
 // RoadLayer is the data layer of type Road, which is used in
@@ -81,7 +166,7 @@ type PollenLayer struct {
 type RoadLayer struct {
 	RoadRiskScore      string `json:"road_risk_score,omitempty"`
 	RoadRisk           string `json:"road_risk,omitempty"`
-	RoadRiskConfidence int    `json:"road_risk_confidence,omitempty"`
+	RoadRiskConfidence *int   `json:"road_risk_confidence,omitempty"`
 	RoadRiskConditions string `json:"road_risk_conditions,omitempty"`
 }
 
@@ -94,7 +179,7 @@ type FireLayer struct {
 // InsuranceLayer is the data layer of type Insurance, which is used in
 // Realtime, Nowcast, Hourly and ClimaCell
 type InsuranceLayer struct {
-	HailBinary *IntData `json:"hail_binary,omitempty"`
+	HailBinary *BoolData `json:"hail_binary,omitempty"`
 }
 
 // PollenTree are the various trees that emit pollen when they're in season
@@ -127,14 +212,28 @@ type PollenWeed struct {
 	Ragweed *PollenData `json:"pollen_weed_ragweed,omitempty"`
 }
 
-// PollenGrass are the various weeds that emit pollen
+// PollenGrass are the various grasses that emit pollen
 type PollenGrass struct {
 	Grass *PollenData `json:"pollen_grass_grass,omitempty"`
 }
 
-// PollenData represents the pollen value
+// PollenData represents the pollen value, an index from 0 (none) to 5 (very high). The value is nil
+// when the API reports null
 type PollenData struct {
-	Value int `json:"value"`
+	Value *int   `json:"value"`
+	Units string `json:"units,omitempty"`
+}
+
+// pollenLevels are the descriptions of the pollen index values
+var pollenLevels = []string{"none", "very low", "low", "medium", "high", "very high"}
+
+// Level returns the description of the pollen index, e.g. "medium"
+func (d *PollenData) Level() string {
+	if d.Value == nil || *d.Value < 0 || *d.Value >= len(pollenLevels) {
+		return "unknown"
+	}
+
+	return pollenLevels[*d.Value]
 }
 
 // FloatData is a response type in which a float and unit are stored
@@ -167,6 +266,125 @@ func (d *IntData) String() string {
 	return fmt.Sprintf("%v %v", *d.Value, d.Units)
 }
 
+// BoolData is a response type in which a bool is stored, the API reports it as 0 or 1
+type BoolData struct {
+	Value *bool `json:"value"`
+}
+
+// UnmarshalJSON unmarshalls the provided byte slice, with either a 0 or 1 or a boolean value,
+// to a BoolData object
+func (d *BoolData) UnmarshalJSON(b []byte) error {
+	var tempStruct struct {
+		Value interface{} `json:"value"`
+	}
+
+	err := json.Unmarshal(b, &tempStruct)
+
+	if err != nil {
+		return err
+	}
+
+	var value bool
+
+	switch v := tempStruct.Value.(type) {
+	case nil:
+		*d = BoolData{}
+		return nil
+	case bool:
+		value = v
+	case float64:
+		value = v != 0
+	default:
+		return fmt.Errorf("invalid bool value %v", v)
+	}
+
+	*d = BoolData{Value: &value}
+	return nil
+}
+
+// String represent the string value of BoolData
+func (d *BoolData) String() string {
+	if d.Value == nil {
+		return ""
+	}
+
+	return fmt.Sprint(*d.Value)
+}
+
+// MinMaxData is a response type in which the minimum and maximum value of a daily forecast field are
+// stored, either one can be nil when the API only reports the other (e.g. precipitation only has a maximum)
+type MinMaxData struct {
+	Min *MinMaxObservation
+	Max *MinMaxObservation
+}
+
+// MinMaxObservation is a minimum or maximum value together with the time at which it's expected
+type MinMaxObservation struct {
+	ObservationTime time.Time
+	FloatData
+}
+
+// minMaxEntry is the json representation of a single MinMaxData observation
+type minMaxEntry struct {
+	ObservationTime string     `json:"observation_time"`
+	Min             *FloatData `json:"min,omitempty"`
+	Max             *FloatData `json:"max,omitempty"`
+}
+
+// UnmarshalJSON unmarshalls the provided byte slice, a json array of min and max observations,
+// to a MinMaxData object
+func (d *MinMaxData) UnmarshalJSON(b []byte) error {
+	var entries []minMaxEntry
+
+	err := json.Unmarshal(b, &entries)
+
+	if err != nil {
+		return err
+	}
+
+	var minMaxData MinMaxData
+
+	for _, entry := range entries {
+		observationTime, err := time.Parse(time.RFC3339, entry.ObservationTime)
+
+		if err != nil {
+			return err
+		}
+
+		if entry.Min != nil {
+			minMaxData.Min = &MinMaxObservation{ObservationTime: observationTime, FloatData: *entry.Min}
+		}
+
+		if entry.Max != nil {
+			minMaxData.Max = &MinMaxObservation{ObservationTime: observationTime, FloatData: *entry.Max}
+		}
+	}
+
+	*d = minMaxData
+	return nil
+}
+
+// MarshalJSON marshals the MinMaxData object to the json array format used by the API
+func (d *MinMaxData) MarshalJSON() ([]byte, error) {
+	entries := []minMaxEntry{}
+
+	if d.Min != nil {
+		entries = append(entries, minMaxEntry{
+			ObservationTime: timeToString(d.Min.ObservationTime),
+			Min:             &d.Min.FloatData,
+		})
+	}
+
+	if d.Max != nil {
+		entries = append(entries, minMaxEntry{
+			ObservationTime: timeToString(d.Max.ObservationTime),
+			Max:             &d.Max.FloatData,
+		})
+	}
+
+	return json.Marshal(entries)
+}
+
 // StringData is a response type in which a string value is stored
 type StringData struct {
 	Value *string `json:"value"`
@@ -181,6 +399,9 @@ func (d *StringData) String() string {
 	return *d.Value
 }
 
+// dateLayout is the layout of the date only values returned by the API
+const dateLayout = "2006-01-02"
+
 // StringData is a response type in which a time.Time value is stored
 // it's used for marshalling and unmarshalling the json datetime responses
 type TimeData struct {
@@ -190,7 +411,7 @@ type TimeData struct {
 // UnmarshalJSON unmarshalls the provided byte slice to a TimeData object
 func (t *TimeData) UnmarshalJSON(b []byte) error {
 	var tempStruct struct {
-		Value string `json:"value"`
+		Value *string `json:"value"`
 	}
 
 	err := json.Unmarshal(b, &tempStruct)
@@ -199,17 +420,38 @@ func (t *TimeData) UnmarshalJSON(b []byte) error {
 		return err
 	}
 
-	pt, err := time.Parse(time.RFC3339, tempStruct.Value)
+	if tempStruct.Value == nil {
+		*t = TimeData{}
+		return nil
+	}
+
+	pt, err := time.Parse(time.RFC3339, *tempStruct.Value)
 
 	if err != nil {
-		return err
+		// The daily forecast reports its observation time as a date without a time
+		var dateErr error
+		pt, dateErr = time.Parse(dateLayout, *tempStruct.Value)
+
+		if dateErr != nil {
+			return err
+		}
 	}
 
 	*t = TimeData{Value: pt}
 	return nil
 }
 
-// MarshalJson marshals the TimeData object to a byte slice
-func (t *TimeData) MarshalJSON() ([]byte, error) {
-	return []byte(t.Value.String()), nil
+// MarshalJSON marshals the TimeData object to a byte slice in the format returned by the API,
+// a zero time is marshalled as null
+func (t TimeData) MarshalJSON() ([]byte, error) {
+	var tempStruct struct {
+		Value *string `json:"value"`
+	}
+
+	if !t.Value.IsZero() {
+		value := t.Value.Format(time.RFC3339Nano)
+		tempStruct.Value = &value
+	}
+
+	return json.Marshal(tempStruct)
 }
@@ -2,7 +2,9 @@ package climacell
 
 import (
 	"encoding/json"
+	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestFloatData_String(t *testing.T) {
@@ -133,3 +135,226 @@ func TestTimeData_UnmarshalJSON(t *testing.T) {
 		t.Errorf("TimeData UnmarshalJSON() error = %v, expected nil", err)
 	}
 }
+
+func TestTimeData_UnmarshalJSON_date(t *testing.T) {
+	data := []byte("{\"value\": \"2020-12-08\"}")
+	var timeData TimeData
+	err := json.Unmarshal(data, &timeData)
+
+	if err != nil {
+		t.Errorf("TimeData UnmarshalJSON() error = %v, expected nil", err)
+	}
+
+	assert.Equal(t, time.Date(2020, 12, 8, 0, 0, 0, 0, time.UTC), timeData.Value)
+}
+
+func TestTimeData_UnmarshalJSON_null(t *testing.T) {
+	data := []byte("{\"value\": null}")
+	timeData := TimeData{Value: time.Now()}
+	err := json.Unmarshal(data, &timeData)
+
+	assert.NoError(t, err)
+	assert.True(t, timeData.Value.IsZero())
+}
+
+func TestTimeData_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		data TimeData
+		want string
+	}{
+		{
+			name: "utc",
+			data: TimeData{Value: time.Date(2020, 12, 7, 20, 6, 54, 764000000, time.UTC)},
+			want: `{"value": "2020-12-07T20:06:54.764Z"}`,
+		},
+		{
+			name: "time zone",
+			data: TimeData{Value: time.Date(2020, 12, 7, 21, 0, 0, 0, time.FixedZone("CET", 3600))},
+			want: `{"value": "2020-12-07T21:00:00+01:00"}`,
+		},
+		{
+			name: "zero",
+			data: TimeData{},
+			want: `{"value": null}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.data)
+
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(got))
+
+			var roundTrip TimeData
+			assert.NoError(t, json.Unmarshal(got, &roundTrip))
+			assert.True(t, tt.data.Value.Equal(roundTrip.Value))
+		})
+	}
+}
+
+func TestApiResponse_Time(t *testing.T) {
+	amsterdam, err := time.LoadLocation("Europe/Amsterdam")
+
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+
+	r := ApiResponse{
+		Latitude:        52.32,
+		Longitude:       4.95,
+		ObservationTime: TimeData{Value: time.Date(2020, 12, 7, 20, 6, 54, 0, time.FixedZone("", 0))},
+	}
+
+	assert.Equal(t, time.UTC, r.Time().Location())
+	assert.Equal(t, 21, r.TimeIn(amsterdam).Hour())
+	assert.Equal(t, Location{Latitude: 52.32, Longitude: 4.95}, r.Location())
+
+	midnight := ApiResponse{ObservationTime: TimeData{Value: time.Date(2020, 12, 8, 0, 0, 0, 0, time.UTC)}}
+	assert.Equal(t, 1, midnight.TimeIn(amsterdam).Hour())
+
+	daily := DailyData{ApiResponse: midnight}
+	assert.Equal(t, time.Date(2020, 12, 8, 0, 0, 0, 0, amsterdam), daily.TimeIn(amsterdam))
+}
+
+func TestTimeData_UnmarshalJSON_invalid(t *testing.T) {
+	data := []byte("{\"value\": \"08-12-2020\"}")
+	var timeData TimeData
+	err := json.Unmarshal(data, &timeData)
+
+	if err == nil {
+		t.Error("TimeData UnmarshalJSON() error = nil, expected non nil")
+	}
+}
+
+func TestMinMaxData_UnmarshalJSON(t *testing.T) {
+	data := []byte(`[
+		{"observation_time": "2020-12-08T06:00:00Z", "min": {"value": 1.44, "units": "C"}},
+		{"observation_time": "2020-12-08T13:00:00Z", "max": {"value": 5.5, "units": "C"}}
+	]`)
+	var minMaxData MinMaxData
+	err := json.Unmarshal(data, &minMaxData)
+
+	if err != nil {
+		t.Errorf("MinMaxData UnmarshalJSON() error = %v, expected nil", err)
+		return
+	}
+
+	assert.Equal(t, 1.44, *minMaxData.Min.Value)
+	assert.Equal(t, "C", minMaxData.Min.Units)
+	assert.Equal(t, time.Date(2020, 12, 8, 6, 0, 0, 0, time.UTC), minMaxData.Min.ObservationTime)
+	assert.Equal(t, 5.5, *minMaxData.Max.Value)
+	assert.Equal(t, time.Date(2020, 12, 8, 13, 0, 0, 0, time.UTC), minMaxData.Max.ObservationTime)
+}
+
+func TestMinMaxData_UnmarshalJSON_invalidTime(t *testing.T) {
+	data := []byte(`[{"observation_time": "yesterday", "min": {"value": 1.44, "units": "C"}}]`)
+	var minMaxData MinMaxData
+	err := json.Unmarshal(data, &minMaxData)
+
+	if err == nil {
+		t.Error("MinMaxData UnmarshalJSON() error = nil, expected non nil")
+	}
+}
+
+func TestMinMaxData_MarshalJSON(t *testing.T) {
+	value := 5.5
+	minMaxData := &MinMaxData{
+		Max: &MinMaxObservation{
+			ObservationTime: time.Date(2020, 12, 8, 13, 0, 0, 0, time.UTC),
+			FloatData:       FloatData{Value: &value, Units: "C"},
+		},
+	}
+
+	b, err := json.Marshal(minMaxData)
+
+	if err != nil {
+		t.Errorf("MinMaxData MarshalJSON() error = %v, expected nil", err)
+		return
+	}
+
+	assert.JSONEq(t, `[{"observation_time": "2020-12-08T13:00:00Z", "max": {"value": 5.5, "units": "C"}}]`, string(b))
+}
+
+func TestPollenData_Level(t *testing.T) {
+	tests := []struct {
+		name  string
+		value *int
+		want  string
+	}{
+		{
+			name:  "none",
+			value: func() *int { v := 0; return &v }(),
+			want:  "none",
+		},
+		{
+			name:  "very high",
+			value: func() *int { v := 5; return &v }(),
+			want:  "very high",
+		},
+		{
+			name:  "out of range",
+			value: func() *int { v := 6; return &v }(),
+			want:  "unknown",
+		},
+		{
+			name:  "null",
+			value: nil,
+			want:  "unknown",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &PollenData{Value: tt.value}
+			assert.Equal(t, tt.want, d.Level())
+		})
+	}
+}
+
+func TestBoolData_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    *bool
+		wantErr bool
+	}{
+		{
+			name: "one",
+			data: `{"value": 1}`,
+			want: func() *bool { v := true; return &v }(),
+		},
+		{
+			name: "zero",
+			data: `{"value": 0}`,
+			want: func() *bool { v := false; return &v }(),
+		},
+		{
+			name: "boolean",
+			data: `{"value": true}`,
+			want: func() *bool { v := true; return &v }(),
+		},
+		{
+			name: "null",
+			data: `{"value": null}`,
+		},
+		{
+			name:    "string",
+			data:    `{"value": "yes"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d BoolData
+			err := json.Unmarshal([]byte(tt.data), &d)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, d.Value)
+		})
+	}
+}
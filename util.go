@@ -6,14 +6,35 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
-func validLatitude(latitude float64) bool {
-	return -59.9 <= latitude && latitude <= 59.9
+// timeNow returns the current time, it's a variable so it can be replaced in tests
+var timeNow = time.Now
+
+func validateFields(endpoint Endpoint, fields ...Field) error {
+	var invalidFields []Field
+
+	for _, providedField := range fields {
+		if !providedField.SupportedBy(endpoint) {
+			invalidFields = append(invalidFields, providedField)
+		}
+	}
+
+	if invalidFields != nil {
+		msg := joinFields(invalidFields, ", ")
+		return newBadRequestError(string(endpoint), fmt.Sprintf("invalid fields provided (%v)", msg))
+	}
+
+	return nil
 }
 
-func validLongitude(longitude float64) bool {
-	return -180 <= longitude && longitude <= 180
+func validateTimeRange(startTime, endTime time.Time) error {
+	if !startTime.IsZero() && !endTime.IsZero() && !endTime.After(startTime) {
+		return ErrInvalidTimeRange
+	}
+
+	return nil
 }
 
 func getURL(baseURL, endpoint string) (*url.URL, error) {
@@ -36,7 +57,23 @@ func floatToString(number float64) string {
 	return fmt.Sprintf("%g", number)
 }
 
-func joinFields(fields []field, sep string) string {
+func timeToString(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// setTimeRange adds the start_time and end_time query parameters, zero times are omitted
+// so the API falls back to its defaults
+func setTimeRange(params url.Values, startTime, endTime time.Time) {
+	if !startTime.IsZero() {
+		params.Set("start_time", timeToString(startTime))
+	}
+
+	if !endTime.IsZero() {
+		params.Set("end_time", timeToString(endTime))
+	}
+}
+
+func joinFields(fields []Field, sep string) string {
 	var fieldNames []string
 
 	for _, f := range fields {
@@ -55,10 +92,14 @@ func checkHTTPError(resp *http.Response, endpoint string) error {
 
 	defer resp.Body.Close()
 
-	if err := json.NewDecoder(resp.Body).Decode(&httpError); err != nil {
-		return err
+	// Gateways in front of the API respond with HTML, fall back to the status text so the error
+	// still carries the status code and endpoint
+	if err := json.NewDecoder(resp.Body).Decode(&httpError); err != nil || httpError.Message == "" {
+		httpError.Message = fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
 	}
 
+	httpError.Endpoint = endpoint
+
 	if resp.StatusCode/100 == 4 || resp.StatusCode/100 == 5 {
 		if resp.StatusCode == 400 {
 			// Bad request
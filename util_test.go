@@ -6,97 +6,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 )
 
-func Test_validLatitude(t *testing.T) {
-	type args struct {
-		latitude float64
-	}
-	tests := []struct {
-		name string
-		args args
-		want bool
-	}{
-		{
-			name: "valid latitude",
-			args: args{latitude: 52},
-			want: true,
-		},
-		{
-			name: "valid latitude lower edge",
-			args: args{latitude: -59.9},
-			want: true,
-		},
-		{
-			name: "valid latitude upper edge",
-			args: args{latitude: 59.9},
-			want: true,
-		},
-		{
-			name: "invalid latitude lower edge",
-			args: args{latitude: -59.91},
-			want: false,
-		},
-		{
-			name: "invalid latitude upper edge",
-			args: args{latitude: 59.91},
-			want: false,
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if got := validLatitude(tt.args.latitude); got != tt.want {
-				t.Errorf("validLatitude() = %v, want %v", got, tt.want)
-			}
-		})
-	}
-}
-
-func Test_validLongitude(t *testing.T) {
-	type args struct {
-		longitude float64
-	}
-	tests := []struct {
-		name string
-		args args
-		want bool
-	}{
-		{
-			name: "valid longitude",
-			args: args{longitude: 100},
-			want: true,
-		},
-		{
-			name: "valid longitude lower edge",
-			args: args{longitude: -180},
-			want: true,
-		},
-		{
-			name: "valid longitude upper edge",
-			args: args{longitude: 180},
-			want: true,
-		},
-		{
-			name: "invalid longitude lower edge",
-			args: args{longitude: -180.01},
-			want: false,
-		},
-		{
-			name: "invalid longitude upper edge",
-			args: args{longitude: 180.01},
-			want: false,
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if got := validLongitude(tt.args.longitude); got != tt.want {
-				t.Errorf("validLongitude() = %v, want %v", got, tt.want)
-			}
-		})
-	}
-}
-
 func Test_getURL(t *testing.T) {
 	baseURL := "http://localhost"
 	endpoint := "/weather/test"
@@ -161,9 +76,29 @@ func Test_floatToString(t *testing.T) {
 	}
 }
 
+func Test_validateTimeRange(t *testing.T) {
+	startTime := time.Date(2020, 12, 7, 20, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, validateTimeRange(startTime, startTime.Add(time.Minute)))
+	assert.NoError(t, validateTimeRange(startTime, time.Time{}))
+	assert.NoError(t, validateTimeRange(time.Time{}, startTime))
+	assert.ErrorIs(t, validateTimeRange(startTime, startTime), ErrInvalidTimeRange)
+	assert.ErrorIs(t, validateTimeRange(startTime, startTime.Add(-time.Minute)), ErrInvalidTimeRange)
+}
+
+func Test_setTimeRange(t *testing.T) {
+	params := url.Values{}
+	startTime := time.Date(2020, 12, 7, 21, 0, 0, 0, time.FixedZone("CET", 3600))
+	setTimeRange(params, startTime, time.Time{})
+
+	assert.Equal(t, "2020-12-07T20:00:00Z", params.Get("start_time"))
+	_, ok := params["end_time"]
+	assert.False(t, ok)
+}
+
 func Test_joinFields(t *testing.T) {
 	type args struct {
-		fields []field
+		fields []Field
 		sep    string
 	}
 	tests := []struct {
@@ -174,7 +109,7 @@ func Test_joinFields(t *testing.T) {
 		{
 			name: "single field",
 			args: args{
-				fields: []field{Temperature},
+				fields: []Field{Temperature},
 				sep:    ", ",
 			},
 			want: "temp",
@@ -182,7 +117,7 @@ func Test_joinFields(t *testing.T) {
 		{
 			name: "multiple fields",
 			args: args{
-				fields: []field{Temperature, CloudCeiling},
+				fields: []Field{Temperature, CloudCeiling},
 				sep:    ", ",
 			},
 			want: "temp, cloud_ceiling",
@@ -190,7 +125,7 @@ func Test_joinFields(t *testing.T) {
 		{
 			name: "empty fields",
 			args: args{
-				fields: []field{},
+				fields: []Field{},
 				sep:    ", ",
 			},
 			want: "",
@@ -324,3 +259,43 @@ func Test_checkHTTPError(t *testing.T) {
 		})
 	}
 }
+
+func Test_checkHTTPError_invalidBody(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		want    string
+		wantErr interface{}
+	}{
+		{
+			name:    "bad gateway html",
+			status:  502,
+			body:    "<html><body>502 Bad Gateway</body></html>",
+			want:    "error /v3/weather_test: 502 Bad Gateway",
+			wantErr: &HTTPError{},
+		},
+		{
+			name:    "service unavailable empty body",
+			status:  503,
+			want:    "error /v3/weather_test: 503 Service Unavailable",
+			wantErr: &HTTPError{},
+		},
+		{
+			name:    "internal server error without message",
+			status:  500,
+			body:    "{}",
+			want:    "internal server error /v3/weather_test: 500 Internal Server Error",
+			wantErr: &InternalServerError{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: tt.status, Body: ioutil.NopCloser(strings.NewReader(tt.body))}
+			err := checkHTTPError(resp, "/v3/weather_test")
+
+			assert.EqualError(t, err, tt.want)
+			assert.IsType(t, tt.wantErr, err)
+		})
+	}
+}
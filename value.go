@@ -0,0 +1,198 @@
+package climacell
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// ValueKind is the kind of a Value, it determines which member of the Value holds the data
+type ValueKind int
+
+const (
+	// KindNone is the kind of a Value of a field the response type doesn't decode, absent fields the
+	// response type does decode keep their kind
+	KindNone ValueKind = iota
+	// KindNumber values are stored in Number
+	KindNumber
+	// KindInt values are stored in Int and Number
+	KindInt
+	// KindString values are stored in Text
+	KindString
+	// KindTime values are stored in Time
+	KindTime
+	// KindBool values are stored in Bool
+	KindBool
+)
+
+var valueKindNames = []string{"none", "number", "int", "string", "time", "bool"}
+
+// String returns the string value of the kind
+func (k ValueKind) String() string {
+	if k < 0 || int(k) >= len(valueKindNames) {
+		return fmt.Sprintf("ValueKind(%d)", int(k))
+	}
+
+	return valueKindNames[k]
+}
+
+// Value is the typed value of a field in a response. Number is also set for KindInt values
+type Value struct {
+	Field   Field
+	Kind    ValueKind
+	Number  float64
+	Int     int
+	Text    string
+	Time    time.Time
+	Bool    bool
+	Units   string
+	Present bool
+}
+
+// String returns the value with its units, or an empty string when the value isn't present
+func (v Value) String() string {
+	if !v.Present {
+		return ""
+	}
+
+	var s string
+
+	switch v.Kind {
+	case KindNumber:
+		s = fmt.Sprintf("%g", v.Number)
+	case KindInt:
+		s = fmt.Sprintf("%d", v.Int)
+	case KindTime:
+		s = v.Time.Format(time.RFC3339)
+	case KindBool:
+		s = fmt.Sprint(v.Bool)
+	default:
+		s = v.Text
+	}
+
+	if v.Units == "" {
+		return s
+	}
+
+	return s + " " + v.Units
+}
+
+// valueOf returns the Value of a struct member of the field. Null values, enums with an unknown value
+// and empty road strings are reported as not present
+func valueOf(f Field, member interface{}) Value {
+	v := Value{Field: f}
+
+	switch m := member.(type) {
+	case *FloatData:
+		v.Kind, v.Units = KindNumber, m.Units
+
+		if m.Value != nil {
+			v.Number, v.Present = *m.Value, true
+		}
+	case *IntData:
+		v.Kind, v.Units = KindInt, m.Units
+
+		if m.Value != nil {
+			v.Int, v.Number, v.Present = *m.Value, float64(*m.Value), true
+		}
+	case *PollenData:
+		v.Kind, v.Units = KindInt, m.Units
+
+		if m.Value != nil {
+			v.Int, v.Number, v.Present = *m.Value, float64(*m.Value), true
+		}
+	case *BoolData:
+		v.Kind = KindBool
+
+		if m.Value != nil {
+			v.Bool, v.Present = *m.Value, true
+		}
+	case *TimeData:
+		v.Kind = KindTime
+		v.Time, v.Present = m.Value, !m.Value.IsZero()
+	case *StringData:
+		v.Kind = KindString
+
+		if m.Value != nil {
+			v.Text, v.Present = *m.Value, true
+		}
+	case *WeatherCodeData:
+		v.Kind = KindString
+		v.Text, v.Present = m.Value.String(), m.Value != WeatherCodeUnknown
+	case *PrecipitationTypeData:
+		v.Kind = KindString
+		v.Text, v.Present = m.Value.String(), m.Value != PrecipitationTypeUnknown
+	case *MoonPhaseData:
+		v.Kind = KindString
+		v.Text, v.Present = m.Value.String(), m.Value != MoonPhaseUnknown
+	case *HealthConcernData:
+		v.Kind = KindString
+		v.Text, v.Present = m.Value.String(), m.Value != HealthConcernUnknown
+	case *[]string:
+		v.Kind = KindString
+		v.Text, v.Present = strings.Join(*m, ","), true
+	case string:
+		v.Kind = KindString
+		v.Text, v.Present = m, m != ""
+	case *int:
+		v.Kind = KindInt
+		v.Int, v.Number, v.Present = *m, float64(*m), true
+	}
+
+	return v
+}
+
+// rangeValues calls fn for each present field of the response in order of declaration, until fn
+// returns false
+func rangeValues(v reflect.Value, fn func(Value) bool) {
+	for _, info := range fieldRegistry {
+		value := valueOf(info.Field, getMember(v, info.Field))
+
+		if value.Present && !fn(value) {
+			return
+		}
+	}
+}
+
+// Get returns the typed value of the field, Present is false when the field isn't in the response.
+// The Units of an absent field are empty, the response doesn't record its unit system
+func (d *RealtimeData) Get(f Field) Value {
+	if member := d.Member(f); member != nil {
+		return valueOf(f, member)
+	}
+
+	return absentValue(reflect.TypeOf(*d), f)
+}
+
+// absentValue returns the Value of a field that isn't in a response of type typ, with the kind of
+// the struct member when typ decodes the field
+func absentValue(typ reflect.Type, f Field) Value {
+	member, ok := structMember(typ, f)
+
+	if !ok || member.Type.Kind() != reflect.Ptr {
+		return Value{Field: f}
+	}
+
+	v := valueOf(f, reflect.New(member.Type.Elem()).Interface())
+	v.Present = false
+
+	return v
+}
+
+// Range calls fn for each field present in the response in order of declaration, until fn returns false
+func (d *RealtimeData) Range(fn func(Value) bool) {
+	rangeValues(reflect.ValueOf(d).Elem(), fn)
+}
+
+// Values returns the values of the fields present in the response in order of declaration
+func (d *RealtimeData) Values() []Value {
+	var values []Value
+
+	d.Range(func(v Value) bool {
+		values = append(values, v)
+		return true
+	})
+
+	return values
+}
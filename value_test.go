@@ -0,0 +1,173 @@
+package climacell
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+func mockRealtimeData(t *testing.T) RealtimeData {
+	mockData, err := ioutil.ReadFile("mocks/realtime_response.json")
+
+	if err != nil {
+		t.Fatal("error setting up mock response file")
+	}
+
+	var d RealtimeData
+
+	if err := json.Unmarshal(mockData, &d); err != nil {
+		t.Fatal("error decoding mock response")
+	}
+
+	return d
+}
+
+func TestRealtimeData_Get(t *testing.T) {
+	d := mockRealtimeData(t)
+
+	tests := []struct {
+		name string
+		f    Field
+		want Value
+	}{
+		{
+			name: "number",
+			f:    Temperature,
+			want: Value{Field: Temperature, Kind: KindNumber, Number: 3.63, Units: "C", Present: true},
+		},
+		{
+			name: "int",
+			f:    Visibility,
+			want: Value{Field: Visibility, Kind: KindInt, Int: 10, Number: 10, Units: "km", Present: true},
+		},
+		{
+			name: "null number",
+			f:    Precipitation,
+			want: Value{Field: Precipitation, Kind: KindNumber, Units: "mm/hr"},
+		},
+		{
+			name: "enum",
+			f:    WeatherCode,
+			want: Value{Field: WeatherCode, Kind: KindString, Text: "mostly_cloudy", Present: true},
+		},
+		{
+			name: "null enum",
+			f:    PrecipitationType,
+			want: Value{Field: PrecipitationType, Kind: KindString, Text: "unknown"},
+		},
+		{
+			name: "time",
+			f:    Sunrise,
+			want: Value{Field: Sunrise, Kind: KindTime, Time: time.Date(2020, 12, 7, 7, 36, 14, 526000000, time.UTC), Present: true},
+		},
+		{
+			name: "pollen",
+			f:    TreeBirchPollen,
+			want: Value{Field: TreeBirchPollen, Kind: KindInt, Int: 3, Number: 3, Units: "Climacell Pollen Index", Present: true},
+		},
+		{
+			name: "bool",
+			f:    HailBinary,
+			want: Value{Field: HailBinary, Kind: KindBool, Present: true},
+		},
+		{
+			name: "missing",
+			f:    TreeOakPollen,
+			want: Value{Field: TreeOakPollen, Kind: KindInt},
+		},
+		{
+			name: "missing number",
+			f:    CloudSatellite,
+			want: Value{Field: CloudSatellite, Kind: KindNumber},
+		},
+		{
+			name: "missing list",
+			f:    WeatherGroups,
+			want: Value{Field: WeatherGroups, Kind: KindString},
+		},
+		{
+			name: "unknown field",
+			f:    Field(99),
+			want: Value{Field: Field(99)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, d.Get(tt.f))
+		})
+	}
+}
+
+func TestRealtimeData_Get_nullAndZero(t *testing.T) {
+	var d RealtimeData
+
+	data := []byte(`{"pollen_tree": {"value": null, "units": "Climacell Pollen Index"}, "road_risk_confidence": 0}`)
+
+	if err := json.Unmarshal(data, &d); err != nil {
+		t.Fatal("error decoding mock response")
+	}
+
+	pollen := d.Get(TreePollen)
+	assert.False(t, pollen.Present, "null pollen isn't a reading")
+	assert.Equal(t, "Climacell Pollen Index", pollen.Units)
+
+	assert.Equal(t, Value{Field: RoadRiskConfidence, Kind: KindInt, Present: true}, d.Get(RoadRiskConfidence))
+	assert.False(t, (&RealtimeData{}).Get(RoadRiskConfidence).Present)
+
+	var fields []Field
+
+	d.Range(func(v Value) bool {
+		fields = append(fields, v.Field)
+		return true
+	})
+
+	assert.Equal(t, []Field{RoadRiskConfidence}, fields)
+}
+
+func TestValue_String(t *testing.T) {
+	d := mockRealtimeData(t)
+
+	assert.Equal(t, "3.63 C", d.Get(Temperature).String())
+	assert.Equal(t, "10 km", d.Get(Visibility).String())
+	assert.Equal(t, "mostly_cloudy", d.Get(WeatherCode).String())
+	assert.Equal(t, "2020-12-07T07:36:14Z", d.Get(Sunrise).String())
+	assert.Equal(t, "false", d.Get(HailBinary).String())
+	assert.Equal(t, "", d.Get(Precipitation).String())
+	assert.Equal(t, "string", KindString.String())
+	assert.Equal(t, "ValueKind(6)", ValueKind(6).String())
+	assert.Equal(t, "ValueKind(-1)", ValueKind(-1).String())
+}
+
+func TestRealtimeData_Range(t *testing.T) {
+	d := mockRealtimeData(t)
+
+	var fields []Field
+
+	d.Range(func(v Value) bool {
+		assert.True(t, v.Present)
+		fields = append(fields, v.Field)
+		return true
+	})
+
+	assert.Equal(t, Temperature, fields[0])
+	assert.NotContains(t, fields, Precipitation)
+	assert.NotContains(t, fields, PrecipitationType)
+	assert.Contains(t, fields, TreeBirchPollen)
+
+	for i := 1; i < len(fields); i++ {
+		assert.Less(t, int(fields[i-1]), int(fields[i]), "fields should be in order of declaration")
+	}
+
+	var count int
+
+	d.Range(func(v Value) bool {
+		count++
+		return count < 3
+	})
+
+	assert.Equal(t, 3, count)
+	assert.Len(t, d.Values(), len(fields))
+	assert.Nil(t, (&RealtimeData{}).Values())
+}